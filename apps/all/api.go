@@ -2,5 +2,12 @@ package all
 
 import (
 	// 注册所有HTTP服务模块, 暴露给框架HTTP服务器加载
+	_ "github.com/opengoats/cmdb/apps/bill/api"
 	_ "github.com/opengoats/cmdb/apps/book/api"
+	_ "github.com/opengoats/cmdb/apps/cost/api"
+	_ "github.com/opengoats/cmdb/apps/host/api"
+	_ "github.com/opengoats/cmdb/apps/idc/api"
+	_ "github.com/opengoats/cmdb/apps/resource/api"
+	_ "github.com/opengoats/cmdb/apps/secret/api"
+	_ "github.com/opengoats/cmdb/apps/task/api"
 )
@@ -3,4 +3,16 @@ package all
 // 注册所有GRPC服务模块, 暴露给框架GRPC服务器加载, 注意 导入有先后顺序
 import (
 	_ "github.com/opengoats/cmdb/apps/book/impl"
+	_ "github.com/opengoats/cmdb/apps/resource/impl"
+	_ "github.com/opengoats/cmdb/apps/secret/impl"
+	// 同步任务依赖凭证服务
+	_ "github.com/opengoats/cmdb/apps/task/impl"
+	// 主机依赖资源服务
+	_ "github.com/opengoats/cmdb/apps/host/impl"
+	// 成本分摊依赖资源服务
+	_ "github.com/opengoats/cmdb/apps/cost/impl"
+	// 账单导入时关联资源
+	_ "github.com/opengoats/cmdb/apps/bill/impl"
+	// IDC资源导入依赖资源服务和主机服务
+	_ "github.com/opengoats/cmdb/apps/idc/impl"
 )
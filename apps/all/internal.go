@@ -1,3 +1,9 @@
 package all
 
 // 注册所有内部服务模块, 无须对外暴露的服务, 用于内部依赖
+import (
+	// 配置了数据文件时加载模拟的资源提供商
+	_ "github.com/opengoats/cmdb/provider/fake"
+	// 配置了Webhook时启动包年包月资源的续费提醒
+	_ "github.com/opengoats/cmdb/apps/reminder"
+)
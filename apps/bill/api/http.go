@@ -0,0 +1,74 @@
+package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/bill"
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/http/response"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+)
+
+var (
+	h = &handler{}
+)
+
+const (
+	// 账单文件最大32M
+	maxBillFileSize = 32 << 20
+)
+
+type handler struct {
+	service bill.ServiceServer
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(bill.AppName)
+	h.service = app.GetGrpcApp(bill.AppName).(bill.ServiceServer)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return bill.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"bills"}
+
+	ws.Route(ws.POST("/import").To(h.ImportBill).
+		Doc("import vendor monthly bill csv, replace the bills of the same vendor, account and month").
+		Consumes("text/csv", "application/octet-stream").
+		Param(ws.QueryParameter("vendor", "bill vendor, e.g. ALIYUN").DataType("string").Required(true)).
+		Param(ws.QueryParameter("account", "bill account").DataType("string").Required(true)).
+		Param(ws.QueryParameter("month", "bill month, e.g. 2023-04").DataType("string").Required(true)).
+		Param(ws.BodyParameter("file", "bill csv file exported from vendor").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(response.NewMessage(bill.ImportBillResult{})).
+		Returns(200, "OK", bill.ImportBillResult{}).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.GET("/").To(h.QueryBill).
+		Doc("query bills").
+		Param(ws.QueryParameter("page_size", "page size").DataType("integer")).
+		Param(ws.QueryParameter("page_number", "page number").DataType("integer")).
+		Param(ws.QueryParameter("month", "bill month, e.g. 2023-04").DataType("string")).
+		Param(ws.QueryParameter("namespace", "resource namespace").DataType("string")).
+		Param(ws.QueryParameter("env", "resource env").DataType("string")).
+		Param(ws.QueryParameter("vendor", "bill vendor").DataType("string")).
+		Param(ws.QueryParameter("account", "bill account").DataType("string")).
+		Param(ws.QueryParameter("c_id", "vendor instance id").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(response.NewMessage(bill.BillSet{})).
+		Returns(200, "OK", bill.BillSet{}).
+		Returns(400, "Bad Request", nil))
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/bill"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/response"
+)
+
+func (h *handler) ImportBill(r *restful.Request, w *restful.Response) {
+	// 读取账单文件
+	data, err := io.ReadAll(http.MaxBytesReader(w.ResponseWriter, r.Request.Body, maxBillFileSize))
+	if err != nil {
+		h.log.Named("ImportBill").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read bill file error, %s", err))
+		return
+	}
+
+	req, err := bill.NewImportBillRequestFromHTTP(r.Request, data)
+	if err != nil {
+		h.log.Named("ImportBill").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse import bill request error, %s", err))
+		return
+	}
+
+	result, err := h.service.ImportBill(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("ImportBill").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, result)
+}
+
+func (h *handler) QueryBill(r *restful.Request, w *restful.Response) {
+	// 加载查询条件
+	req, err := bill.NewQueryBillRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("QueryBill").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse query bill request error, %s", err))
+		return
+	}
+
+	set, err := h.service.QueryBill(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("QueryBill").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, set)
+}
@@ -0,0 +1,5 @@
+package bill
+
+const (
+	AppName = "bill"
+)
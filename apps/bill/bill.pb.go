@@ -0,0 +1,807 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.22.0
+// source: apps/bill/pb/bill.proto
+
+package bill
+
+import (
+	resource "github.com/opengoats/cmdb/apps/resource"
+	request "github.com/opengoats/goat/http/request"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Bill struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 账单Id
+	// @gotags: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// 导入时间
+	// @gotags: json:"create_at"
+	CreateAt int64 `protobuf:"varint,2,opt,name=create_at,json=createAt,proto3" json:"create_at"`
+	// 资源提供商
+	// @gotags: json:"vendor"
+	Vendor resource.Vendor `protobuf:"varint,3,opt,name=vendor,proto3,enum=opengoats.cmdb.resource.Vendor" json:"vendor"`
+	// 账单所属的账号
+	// @gotags: json:"account"
+	Account string `protobuf:"bytes,4,opt,name=account,proto3" json:"account"`
+	// 账单月份, 格式: 2006-01
+	// @gotags: json:"month"
+	Month string `protobuf:"bytes,5,opt,name=month,proto3" json:"month"`
+	// 关联的资源Id, 没有关联到资源时为空
+	// @gotags: json:"resource_id"
+	ResourceId string `protobuf:"bytes,6,opt,name=resource_id,json=resourceId,proto3" json:"resource_id"`
+	// 云商的实例Id, 与resource.c_id对应
+	// @gotags: json:"c_id"
+	Cid string `protobuf:"bytes,7,opt,name=cid,proto3" json:"c_id"`
+	// 实例名称
+	// @gotags: json:"instance_name"
+	InstanceName string `protobuf:"bytes,8,opt,name=instance_name,json=instanceName,proto3" json:"instance_name"`
+	// 产品名称
+	// @gotags: json:"product"
+	Product string `protobuf:"bytes,9,opt,name=product,proto3" json:"product"`
+	// 地域
+	// @gotags: json:"region"
+	Region string `protobuf:"bytes,10,opt,name=region,proto3" json:"region"`
+	// 资源所属空间, 导入时从资源上获取
+	// @gotags: json:"namespace"
+	Namespace string `protobuf:"bytes,11,opt,name=namespace,proto3" json:"namespace"`
+	// 资源所属环境, 导入时从资源上获取
+	// @gotags: json:"env"
+	Env string `protobuf:"bytes,12,opt,name=env,proto3" json:"env"`
+	// 付费方式
+	// @gotags: json:"pay_type"
+	PayType string `protobuf:"bytes,13,opt,name=pay_type,json=payType,proto3" json:"pay_type"`
+	// 币种
+	// @gotags: json:"currency"
+	Currency string `protobuf:"bytes,14,opt,name=currency,proto3" json:"currency"`
+	// 应付金额
+	// @gotags: json:"amount"
+	Amount float64 `protobuf:"fixed64,15,opt,name=amount,proto3" json:"amount"`
+}
+
+func (x *Bill) Reset() {
+	*x = Bill{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_bill_pb_bill_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bill) ProtoMessage() {}
+
+func (x *Bill) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_bill_pb_bill_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bill.ProtoReflect.Descriptor instead.
+func (*Bill) Descriptor() ([]byte, []int) {
+	return file_apps_bill_pb_bill_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Bill) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Bill) GetCreateAt() int64 {
+	if x != nil {
+		return x.CreateAt
+	}
+	return 0
+}
+
+func (x *Bill) GetVendor() resource.Vendor {
+	if x != nil {
+		return x.Vendor
+	}
+	return resource.Vendor(0)
+}
+
+func (x *Bill) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *Bill) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *Bill) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *Bill) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *Bill) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *Bill) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *Bill) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Bill) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Bill) GetEnv() string {
+	if x != nil {
+		return x.Env
+	}
+	return ""
+}
+
+func (x *Bill) GetPayType() string {
+	if x != nil {
+		return x.PayType
+	}
+	return ""
+}
+
+func (x *Bill) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Bill) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ImportBillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源提供商, 按厂商选择账单解析器
+	// @gotags: json:"vendor"
+	Vendor resource.Vendor `protobuf:"varint,1,opt,name=vendor,proto3,enum=opengoats.cmdb.resource.Vendor" json:"vendor"`
+	// 账单所属的账号
+	// @gotags: json:"account" validate:"required,lte=255"
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account" validate:"required,lte=255"`
+	// 账单月份, 格式: 2006-01
+	// @gotags: json:"month" validate:"required"
+	Month string `protobuf:"bytes,3,opt,name=month,proto3" json:"month" validate:"required"`
+	// 厂商导出的CSV账单文件
+	// @gotags: json:"data" validate:"required"
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data" validate:"required"`
+}
+
+func (x *ImportBillRequest) Reset() {
+	*x = ImportBillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_bill_pb_bill_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportBillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBillRequest) ProtoMessage() {}
+
+func (x *ImportBillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_bill_pb_bill_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBillRequest.ProtoReflect.Descriptor instead.
+func (*ImportBillRequest) Descriptor() ([]byte, []int) {
+	return file_apps_bill_pb_bill_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportBillRequest) GetVendor() resource.Vendor {
+	if x != nil {
+		return x.Vendor
+	}
+	return resource.Vendor(0)
+}
+
+func (x *ImportBillRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *ImportBillRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *ImportBillRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportBillResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源提供商
+	// @gotags: json:"vendor"
+	Vendor resource.Vendor `protobuf:"varint,1,opt,name=vendor,proto3,enum=opengoats.cmdb.resource.Vendor" json:"vendor"`
+	// 账单所属的账号
+	// @gotags: json:"account"
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
+	// 账单月份
+	// @gotags: json:"month"
+	Month string `protobuf:"bytes,3,opt,name=month,proto3" json:"month"`
+	// 导入的账单条数
+	// @gotags: json:"total"
+	Total int64 `protobuf:"varint,4,opt,name=total,proto3" json:"total"`
+	// 关联到资源的账单条数
+	// @gotags: json:"matched"
+	Matched int64 `protobuf:"varint,5,opt,name=matched,proto3" json:"matched"`
+	// 被替换的历史账单条数, 重复导入时会替换同一个账号同一个月份的账单
+	// @gotags: json:"replaced"
+	Replaced int64 `protobuf:"varint,6,opt,name=replaced,proto3" json:"replaced"`
+	// 导入的总金额
+	// @gotags: json:"amount"
+	Amount float64 `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount"`
+}
+
+func (x *ImportBillResult) Reset() {
+	*x = ImportBillResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_bill_pb_bill_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportBillResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBillResult) ProtoMessage() {}
+
+func (x *ImportBillResult) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_bill_pb_bill_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBillResult.ProtoReflect.Descriptor instead.
+func (*ImportBillResult) Descriptor() ([]byte, []int) {
+	return file_apps_bill_pb_bill_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportBillResult) GetVendor() resource.Vendor {
+	if x != nil {
+		return x.Vendor
+	}
+	return resource.Vendor(0)
+}
+
+func (x *ImportBillResult) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *ImportBillResult) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *ImportBillResult) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportBillResult) GetMatched() int64 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *ImportBillResult) GetReplaced() int64 {
+	if x != nil {
+		return x.Replaced
+	}
+	return 0
+}
+
+func (x *ImportBillResult) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type QueryBillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分页参数
+	// @gotags: json:"page"
+	Page *request.PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page"`
+	// 账单月份
+	// @gotags: json:"month"
+	Month string `protobuf:"bytes,2,opt,name=month,proto3" json:"month"`
+	// 资源所属空间
+	// @gotags: json:"namespace"
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace"`
+	// 资源所属环境
+	// @gotags: json:"env"
+	Env string `protobuf:"bytes,4,opt,name=env,proto3" json:"env"`
+	// 资源提供商
+	// @gotags: json:"vendor"
+	Vendor *resource.Vendor `protobuf:"varint,5,opt,name=vendor,proto3,enum=opengoats.cmdb.resource.Vendor,oneof" json:"vendor"`
+	// 账单所属的账号
+	// @gotags: json:"account"
+	Account string `protobuf:"bytes,6,opt,name=account,proto3" json:"account"`
+	// 云商的实例Id
+	// @gotags: json:"c_id"
+	Cid string `protobuf:"bytes,7,opt,name=cid,proto3" json:"c_id"`
+}
+
+func (x *QueryBillRequest) Reset() {
+	*x = QueryBillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_bill_pb_bill_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBillRequest) ProtoMessage() {}
+
+func (x *QueryBillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_bill_pb_bill_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryBillRequest.ProtoReflect.Descriptor instead.
+func (*QueryBillRequest) Descriptor() ([]byte, []int) {
+	return file_apps_bill_pb_bill_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryBillRequest) GetPage() *request.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *QueryBillRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *QueryBillRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *QueryBillRequest) GetEnv() string {
+	if x != nil {
+		return x.Env
+	}
+	return ""
+}
+
+func (x *QueryBillRequest) GetVendor() resource.Vendor {
+	if x != nil && x.Vendor != nil {
+		return *x.Vendor
+	}
+	return resource.Vendor(0)
+}
+
+func (x *QueryBillRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *QueryBillRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+type BillSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分页时，返回总数量
+	// @gotags: json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	// 符合条件的账单总金额
+	// @gotags: json:"amount"
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount"`
+	// 一页的数据
+	// @gotags: json:"items"
+	Items []*Bill `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
+}
+
+func (x *BillSet) Reset() {
+	*x = BillSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_bill_pb_bill_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillSet) ProtoMessage() {}
+
+func (x *BillSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_bill_pb_bill_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillSet.ProtoReflect.Descriptor instead.
+func (*BillSet) Descriptor() ([]byte, []int) {
+	return file_apps_bill_pb_bill_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BillSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BillSet) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BillSet) GetItems() []*Bill {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_apps_bill_pb_bill_proto protoreflect.FileDescriptor
+
+var file_apps_bill_pb_bill_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x62,
+	0x69, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6f, 0x70, 0x65, 0x6e, 0x67,
+	0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x1a, 0x2c,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x67,
+	0x6f, 0x61, 0x74, 0x73, 0x2f, 0x67, 0x6f, 0x61, 0x74, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x61, 0x67,
+	0x65, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x61, 0x70,
+	0x70, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x03,
+	0x0a, 0x04, 0x42, 0x69, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e,
+	0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x76,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x52, 0x06, 0x76, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdf, 0x01, 0x0a, 0x10, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a,
+	0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x52, 0x06,
+	0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x83, 0x02, 0x0a, 0x10, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x34, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x67, 0x6f, 0x61, 0x74, 0x2e, 0x70,
+	0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x3c, 0x0a, 0x06, 0x76,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06,
+	0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
+	0x22, 0x68, 0x0a, 0x07, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67,
+	0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x42,
+	0x69, 0x6c, 0x6c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x32, 0xb8, 0x01, 0x0a, 0x07, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x69, 0x6c, 0x6c, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73,
+	0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x62, 0x69,
+	0x6c, 0x6c, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x50, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x6c, 0x6c,
+	0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
+	0x62, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f,
+	0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x42, 0x69,
+	0x6c, 0x6c, 0x53, 0x65, 0x74, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2f, 0x63, 0x6d,
+	0x64, 0x62, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_bill_pb_bill_proto_rawDescOnce sync.Once
+	file_apps_bill_pb_bill_proto_rawDescData = file_apps_bill_pb_bill_proto_rawDesc
+)
+
+func file_apps_bill_pb_bill_proto_rawDescGZIP() []byte {
+	file_apps_bill_pb_bill_proto_rawDescOnce.Do(func() {
+		file_apps_bill_pb_bill_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_bill_pb_bill_proto_rawDescData)
+	})
+	return file_apps_bill_pb_bill_proto_rawDescData
+}
+
+var file_apps_bill_pb_bill_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_apps_bill_pb_bill_proto_goTypes = []interface{}{
+	(*Bill)(nil),                // 0: opengoats.cmdb.bill.Bill
+	(*ImportBillRequest)(nil),   // 1: opengoats.cmdb.bill.ImportBillRequest
+	(*ImportBillResult)(nil),    // 2: opengoats.cmdb.bill.ImportBillResult
+	(*QueryBillRequest)(nil),    // 3: opengoats.cmdb.bill.QueryBillRequest
+	(*BillSet)(nil),             // 4: opengoats.cmdb.bill.BillSet
+	(resource.Vendor)(0),        // 5: opengoats.cmdb.resource.Vendor
+	(*request.PageRequest)(nil), // 6: opengoats.goat.page.PageRequest
+}
+var file_apps_bill_pb_bill_proto_depIdxs = []int32{
+	5, // 0: opengoats.cmdb.bill.Bill.vendor:type_name -> opengoats.cmdb.resource.Vendor
+	5, // 1: opengoats.cmdb.bill.ImportBillRequest.vendor:type_name -> opengoats.cmdb.resource.Vendor
+	5, // 2: opengoats.cmdb.bill.ImportBillResult.vendor:type_name -> opengoats.cmdb.resource.Vendor
+	6, // 3: opengoats.cmdb.bill.QueryBillRequest.page:type_name -> opengoats.goat.page.PageRequest
+	5, // 4: opengoats.cmdb.bill.QueryBillRequest.vendor:type_name -> opengoats.cmdb.resource.Vendor
+	0, // 5: opengoats.cmdb.bill.BillSet.items:type_name -> opengoats.cmdb.bill.Bill
+	1, // 6: opengoats.cmdb.bill.Service.ImportBill:input_type -> opengoats.cmdb.bill.ImportBillRequest
+	3, // 7: opengoats.cmdb.bill.Service.QueryBill:input_type -> opengoats.cmdb.bill.QueryBillRequest
+	2, // 8: opengoats.cmdb.bill.Service.ImportBill:output_type -> opengoats.cmdb.bill.ImportBillResult
+	4, // 9: opengoats.cmdb.bill.Service.QueryBill:output_type -> opengoats.cmdb.bill.BillSet
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_apps_bill_pb_bill_proto_init() }
+func file_apps_bill_pb_bill_proto_init() {
+	if File_apps_bill_pb_bill_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_bill_pb_bill_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_bill_pb_bill_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportBillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_bill_pb_bill_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportBillResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_bill_pb_bill_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_bill_pb_bill_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_apps_bill_pb_bill_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_bill_pb_bill_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_apps_bill_pb_bill_proto_goTypes,
+		DependencyIndexes: file_apps_bill_pb_bill_proto_depIdxs,
+		MessageInfos:      file_apps_bill_pb_bill_proto_msgTypes,
+	}.Build()
+	File_apps_bill_pb_bill_proto = out.File
+	file_apps_bill_pb_bill_proto_rawDesc = nil
+	file_apps_bill_pb_bill_proto_goTypes = nil
+	file_apps_bill_pb_bill_proto_depIdxs = nil
+}
@@ -0,0 +1,136 @@
+package bill
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/opengoats/goat/http/request"
+	"github.com/rs/xid"
+
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+const (
+	// MonthLayout 账单月份的格式
+	MonthLayout = "2006-01"
+)
+
+var (
+	validate = validator.New()
+)
+
+func (r *ImportBillRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+	if _, err := time.Parse(MonthLayout, r.Month); err != nil {
+		return fmt.Errorf("month %s format must be %s", r.Month, MonthLayout)
+	}
+	return nil
+}
+
+func (r *QueryBillRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewDefaultBill() *Bill {
+	return &Bill{}
+}
+
+func NewImportBillRequest(vendor resource.Vendor, account, month string, data []byte) *ImportBillRequest {
+	return &ImportBillRequest{
+		Vendor:  vendor,
+		Account: account,
+		Month:   month,
+		Data:    data,
+	}
+}
+
+// NewImportBillRequestFromHTTP 账单文件为请求体, 其他参数从Query参数中加载
+func NewImportBillRequestFromHTTP(r *http.Request, data []byte) (*ImportBillRequest, error) {
+	qs := r.URL.Query()
+
+	vendor, err := resource.ParseVendorFromString(qs.Get("vendor"))
+	if err != nil {
+		return nil, err
+	}
+	return NewImportBillRequest(vendor, qs.Get("account"), qs.Get("month"), data), nil
+}
+
+// Prepare 填充导入的账单, 文件中的账单月份必须与导入的月份一致
+func (r *ImportBillRequest) Prepare(bills []*Bill) error {
+	now := time.Now().UnixMilli()
+	for i := range bills {
+		b := bills[i]
+		if b.Month != "" && b.Month != r.Month {
+			return fmt.Errorf("bill %s month %s not match import month %s", b.Cid, b.Month, r.Month)
+		}
+		b.Id = xid.New().String()
+		b.CreateAt = now
+		b.Vendor = r.Vendor
+		b.Account = r.Account
+		b.Month = r.Month
+	}
+	return nil
+}
+
+func NewImportBillResult(req *ImportBillRequest) *ImportBillResult {
+	return &ImportBillResult{
+		Vendor:  req.Vendor,
+		Account: req.Account,
+		Month:   req.Month,
+	}
+}
+
+// Add 统计导入的账单
+func (r *ImportBillResult) Add(b *Bill) {
+	r.Total++
+	r.Amount += b.Amount
+	if b.ResourceId != "" {
+		r.Matched++
+	}
+}
+
+func NewBillSet() *BillSet {
+	return &BillSet{
+		Items: []*Bill{},
+	}
+}
+
+func (s *BillSet) Add(item *Bill) {
+	s.Items = append(s.Items, item)
+}
+
+func NewQueryBillRequest() *QueryBillRequest {
+	return &QueryBillRequest{
+		Page: request.NewDefaultPageRequest(),
+	}
+}
+
+func NewQueryBillRequestFromHTTP(r *http.Request) (*QueryBillRequest, error) {
+	qs := r.URL.Query()
+
+	req := &QueryBillRequest{
+		Page:      request.NewPageRequestFromHTTP(r),
+		Month:     qs.Get("month"),
+		Namespace: qs.Get("namespace"),
+		Env:       qs.Get("env"),
+		Account:   qs.Get("account"),
+		Cid:       qs.Get("c_id"),
+	}
+	if vd := qs.Get("vendor"); vd != "" {
+		v, err := resource.ParseVendorFromString(vd)
+		if err != nil {
+			return nil, err
+		}
+		req.Vendor = &v
+	}
+
+	return req, nil
+}
@@ -0,0 +1,221 @@
+package impl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/sqlbuilder"
+
+	"github.com/opengoats/cmdb/apps/bill"
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+const (
+	// 批量操作时每批的数量, 避免参数过多
+	batchSize = 500
+)
+
+// billResource 账单关联的资源
+type billResource struct {
+	id        string
+	namespace string
+	env       string
+}
+
+// link 通过(vendor, c_id)把账单关联到资源, 并记录资源当时所属的空间和环境
+func (s *service) link(ctx context.Context, vendor resource.Vendor, bills []*bill.Bill) error {
+	cids, exist := []string{}, map[string]bool{}
+	for i := range bills {
+		if cid := bills[i].Cid; cid != "" && !exist[cid] {
+			exist[cid] = true
+			cids = append(cids, cid)
+		}
+	}
+
+	resources := map[string]*billResource{}
+	for start := 0; start < len(cids); start += batchSize {
+		end := start + batchSize
+		if end > len(cids) {
+			end = len(cids)
+		}
+		if err := s.queryBillResource(ctx, vendor, cids[start:end], resources); err != nil {
+			return err
+		}
+	}
+
+	for i := range bills {
+		if r, ok := resources[bills[i].Cid]; ok {
+			bills[i].ResourceId = r.id
+			bills[i].Namespace = r.namespace
+			bills[i].Env = r.env
+		}
+	}
+	return nil
+}
+
+func (s *service) queryBillResource(ctx context.Context, vendor resource.Vendor, cids []string, resources map[string]*billResource) error {
+	query := sqlbuilder.NewQuery(sqlQueryBillResource)
+	query.Where("vendor = ?", vendor)
+	query.Where("c_id IN (?"+strings.Repeat(",?", len(cids)-1)+")", resource.StringsToArgs(cids)...)
+
+	querySQL, args := query.Build()
+	s.log.Named("ImportBill").Debugf("sql: %s; %v", querySQL, args)
+	rows, err := s.db.QueryContext(ctx, querySQL, args...)
+	if err != nil {
+		s.log.Named("ImportBill").Error(err)
+		return exception.NewInternalServerError("query bill resource err %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid string
+		r := &billResource{}
+		if err := rows.Scan(&r.id, &cid, &r.namespace, &r.env); err != nil {
+			s.log.Named("ImportBill").Error(err)
+			return exception.NewInternalServerError("query bill resource err %s", err)
+		}
+		resources[cid] = r
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("ImportBill").Error(err)
+		return exception.NewInternalServerError("query bill resource err %s", err)
+	}
+
+	return nil
+}
+
+// replace 在一个事务中删除同一个账号同一个月份的历史账单, 再批量插入, 保证重复导入不会重复计算
+func (s *service) replace(ctx context.Context, req *bill.ImportBillRequest, bills []*bill.Bill) (replaced int64, err error) {
+	// 开启一个事务
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, exception.NewInternalServerError("import bill err %s", err)
+	}
+
+	// 通过Defer处理事务提交方式
+	// 1. 无报错，则Commit 事务
+	// 2. 有报错，则Rollback 事务
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				s.log.Error("rollback error, %s", err.Error())
+			}
+		} else {
+			// 提交失败时通过返回值报告, 避免把没有写入的变更作为成功返回
+			if err = tx.Commit(); err != nil {
+				s.log.Error("commit error, %s", err.Error())
+				err = exception.NewInternalServerError("commit tx err %s", err)
+			}
+		}
+	}()
+
+	s.log.Named("ImportBill").Debugf("sql: %s", sqlDeleteBill)
+	result, err := tx.ExecContext(ctx, sqlDeleteBill, req.Vendor, req.Account, req.Month)
+	if err != nil {
+		return 0, exception.NewInternalServerError("delete bill err %s", err)
+	}
+	replaced, _ = result.RowsAffected()
+
+	for start := 0; start < len(bills); start += batchSize {
+		end := start + batchSize
+		if end > len(bills) {
+			end = len(bills)
+		}
+
+		values := make([]string, 0, end-start)
+		args := make([]interface{}, 0, (end-start)*15)
+		for _, b := range bills[start:end] {
+			values = append(values, sqlInsertBillValues)
+			args = append(args,
+				b.Id, b.CreateAt, b.Vendor, b.Account, b.Month, b.ResourceId, b.Cid, b.InstanceName, b.Product, b.Region,
+				b.Namespace, b.Env, b.PayType, b.Currency, b.Amount,
+			)
+		}
+
+		insertSQL := sqlInsertBill + strings.Join(values, ",")
+		s.log.Named("ImportBill").Debugf("sql: %s", insertSQL)
+		if _, err = tx.ExecContext(ctx, insertSQL, args...); err != nil {
+			return 0, exception.NewInternalServerError("insert bill err %s", err)
+		}
+	}
+
+	return replaced, nil
+}
+
+func (s *service) query(ctx context.Context, req *bill.QueryBillRequest) (*bill.BillSet, error) {
+	query := sqlbuilder.NewQuery(sqlQueryBill)
+	if req.Month != "" {
+		query.Where("month = ?", req.Month)
+	}
+	if req.Namespace != "" {
+		query.Where("namespace = ?", req.Namespace)
+	}
+	if req.Env != "" {
+		query.Where("env = ?", req.Env)
+	}
+	if req.Vendor != nil {
+		query.Where("vendor = ?", *req.Vendor)
+	}
+	if req.Account != "" {
+		query.Where("account = ?", req.Account)
+	}
+	if req.Cid != "" {
+		query.Where("c_id = ?", req.Cid)
+	}
+
+	set := bill.NewBillSet()
+
+	// 获取total和总金额
+	countSQL, args := query.BuildFromNewBase(sqlCountBill)
+	s.log.Named("QueryBill").Debugf("sql: %s; %v", countSQL, args)
+	countStmt, err := s.db.PrepareContext(ctx, countSQL)
+	if err != nil {
+		s.log.Named("QueryBill").Error(err)
+		return nil, exception.NewInternalServerError("count bill err %s", err)
+	}
+	defer countStmt.Close()
+
+	err = countStmt.QueryRowContext(ctx, args...).Scan(&set.Total, &set.Amount)
+	if err != nil {
+		s.log.Named("QueryBill").Error(err)
+		return nil, exception.NewInternalServerError("count bill err %s", err)
+	}
+
+	// 获取分页数据
+	querySQL, args := query.Order("amount").Desc().
+		Limit(req.Page.ComputeOffset(), uint(req.Page.PageSize)).Build()
+	s.log.Named("QueryBill").Debugf("sql: %s; %v", querySQL, args)
+	queryStmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("QueryBill").Error(err)
+		return nil, exception.NewInternalServerError("query bill err %s", err)
+	}
+	defer queryStmt.Close()
+
+	rows, err := queryStmt.QueryContext(ctx, args...)
+	if err != nil {
+		s.log.Named("QueryBill").Error(err)
+		return nil, exception.NewInternalServerError("query bill err %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ins := bill.NewDefaultBill()
+		err := rows.Scan(
+			&ins.Id, &ins.CreateAt, &ins.Vendor, &ins.Account, &ins.Month, &ins.ResourceId, &ins.Cid, &ins.InstanceName,
+			&ins.Product, &ins.Region, &ins.Namespace, &ins.Env, &ins.PayType, &ins.Currency, &ins.Amount,
+		)
+		if err != nil {
+			s.log.Named("QueryBill").Error(err)
+			return nil, exception.NewInternalServerError("query bill err %s", err)
+		}
+		set.Add(ins)
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("QueryBill").Error(err)
+		return nil, exception.NewInternalServerError("query bill err %s", err)
+	}
+
+	return set, nil
+}
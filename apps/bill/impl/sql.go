@@ -0,0 +1,23 @@
+package impl
+
+const (
+	// 多行插入时每行的占位符
+	sqlInsertBillValues = `(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`
+	sqlInsertBill       = `INSERT INTO bill (
+		id,create_at,vendor,account,month,resource_id,c_id,instance_name,product,region,
+		namespace,env,pay_type,currency,amount
+	) VALUES `
+
+	// 重复导入时, 整体替换同一个账号同一个月份的账单
+	sqlDeleteBill = `DELETE FROM bill WHERE vendor = ? AND account = ? AND month = ?`
+
+	// 通过(vendor, c_id)关联资源
+	sqlQueryBillResource = `SELECT id,c_id,namespace,env FROM resource`
+
+	sqlQueryBill = `SELECT 
+		id,create_at,vendor,account,month,resource_id,c_id,instance_name,product,region,
+		namespace,env,pay_type,currency,amount 
+	FROM bill`
+
+	sqlCountBill = `SELECT COUNT(*),IFNULL(SUM(amount),0) FROM bill`
+)
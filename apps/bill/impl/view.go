@@ -0,0 +1,71 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/request"
+
+	"github.com/opengoats/cmdb/apps/bill"
+)
+
+func (s *service) ImportBill(ctx context.Context, req *bill.ImportBillRequest) (*bill.ImportBillResult, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("ImportBill").Error(err)
+		return nil, exception.NewBadRequest("validate import bill error, %s", err)
+	}
+
+	// 按厂商解析账单文件
+	p, err := bill.GetParser(req.Vendor)
+	if err != nil {
+		return nil, exception.NewBadRequest("%s", err)
+	}
+	bills, err := p.Parse(bytes.NewReader(req.Data))
+	if err != nil {
+		s.log.Named("ImportBill").Error(err)
+		return nil, exception.NewBadRequest("parse bill error, %s", err)
+	}
+	if err := req.Prepare(bills); err != nil {
+		return nil, exception.NewBadRequest("%s", err)
+	}
+
+	// 关联资源
+	if err := s.link(ctx, req.Vendor, bills); err != nil {
+		return nil, err
+	}
+
+	// 整体替换
+	result := bill.NewImportBillResult(req)
+	result.Replaced, err = s.replace(ctx, req, bills)
+	if err != nil {
+		return nil, err
+	}
+	for i := range bills {
+		result.Add(bills[i])
+	}
+	return result, nil
+}
+
+func (s *service) QueryBill(ctx context.Context, req *bill.QueryBillRequest) (*bill.BillSet, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("QueryBill").Error(err)
+		return nil, exception.NewBadRequest("validate query bill error, %s", err)
+	}
+
+	// 分页默认值填充
+	if req.Page == nil {
+		req.Page = request.NewDefaultPageRequest()
+	}
+	if req.Page.PageSize == 0 {
+		req.Page.PageSize = request.DefaultPageSize
+	}
+	if req.Page.PageNumber == 0 {
+		req.Page.PageNumber = request.DefaultPageNumber
+	}
+
+	// 数据库查询
+	return s.query(ctx, req)
+}
@@ -0,0 +1,224 @@
+package bill
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+var (
+	parsers = map[resource.Vendor]Parser{}
+)
+
+// Parser 厂商账单解析器, 每个厂商一个
+type Parser interface {
+	Vendor() resource.Vendor
+	// Parse 解析厂商导出的账单文件, 账单的月份以文件中的为准, 文件中没有时为空
+	Parse(r io.Reader) ([]*Bill, error)
+}
+
+// RegistryParser 注册账单解析器, 同一个厂商只允许注册一次
+func RegistryParser(p Parser) {
+	if _, ok := parsers[p.Vendor()]; ok {
+		panic(fmt.Sprintf("bill parser %s has registed", p.Vendor()))
+	}
+
+	parsers[p.Vendor()] = p
+}
+
+// GetParser 查询厂商的账单解析器
+func GetParser(vendor resource.Vendor) (Parser, error) {
+	p, ok := parsers[vendor]
+	if !ok {
+		return nil, fmt.Errorf("bill parser %s not registed", vendor)
+	}
+
+	return p, nil
+}
+
+// 账单中的字段
+const (
+	columnMonth        = "month"
+	columnCid          = "c_id"
+	columnInstanceName = "instance_name"
+	columnProduct      = "product"
+	columnRegion       = "region"
+	columnPayType      = "pay_type"
+	columnCurrency     = "currency"
+	columnAmount       = "amount"
+)
+
+// csvParser 按表头解析CSV账单, 每个字段可以对应多个表头名称, 兼容中英文导出
+type csvParser struct {
+	vendor  resource.Vendor
+	columns map[string][]string
+}
+
+func (p *csvParser) Vendor() resource.Vendor {
+	return p.vendor
+}
+
+func (p *csvParser) Parse(r io.Reader) ([]*Bill, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read %s bill header error, %s", p.vendor, err)
+	}
+	index, err := p.index(header)
+	if err != nil {
+		return nil, err
+	}
+
+	bills := []*Bill{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read %s bill line %d error, %s", p.vendor, line, err)
+		}
+
+		get := func(column string) string {
+			i, ok := index[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		// 跳过空行和汇总行
+		if get(columnCid) == "" && get(columnAmount) == "" {
+			continue
+		}
+
+		amount, err := ParseAmount(get(columnAmount))
+		if err != nil {
+			return nil, fmt.Errorf("parse %s bill line %d amount error, %s", p.vendor, line, err)
+		}
+
+		ins := NewDefaultBill()
+		ins.Vendor = p.vendor
+		ins.Month = NormalizeMonth(get(columnMonth))
+		ins.Cid = get(columnCid)
+		ins.InstanceName = get(columnInstanceName)
+		ins.Product = get(columnProduct)
+		ins.Region = get(columnRegion)
+		ins.PayType = get(columnPayType)
+		ins.Currency = get(columnCurrency)
+		ins.Amount = amount
+		bills = append(bills, ins)
+	}
+
+	return bills, nil
+}
+
+// index 查找每个字段在表头中的位置, 实例Id和金额为必须字段
+func (p *csvParser) index(header []string) (map[string]int, error) {
+	index := map[string]int{}
+	for i := range header {
+		// 去掉Excel导出时带的BOM
+		name := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff")))
+		for column, names := range p.columns {
+			if _, ok := index[column]; ok {
+				continue
+			}
+			for _, n := range names {
+				if strings.ToLower(n) == name {
+					index[column] = i
+					break
+				}
+			}
+		}
+	}
+
+	for _, column := range []string{columnCid, columnAmount} {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf("%s bill header missing column %s, support: %s", p.vendor, column, p.columns[column])
+		}
+	}
+	return index, nil
+}
+
+// ParseAmount 解析金额, 忽略千分位和货币符号
+func ParseAmount(s string) (float64, error) {
+	s = strings.NewReplacer(",", "", "¥", "", "￥", "", "$", "", " ", "").Replace(s)
+	if s == "" || s == "-" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// NormalizeMonth 把账单中的月份转换为 2006-01 格式, 比如 2023/04, 202304
+func NormalizeMonth(s string) string {
+	s = strings.NewReplacer("/", "-", ".", "-", "年", "-", "月", "").Replace(strings.TrimSpace(s))
+	if len(s) == 6 && !strings.Contains(s, "-") {
+		s = s[:4] + "-" + s[4:]
+	}
+	if len(s) == 6 && s[4] == '-' {
+		s = s[:5] + "0" + s[5:]
+	}
+	return s
+}
+
+func init() {
+	RegistryParser(&csvParser{
+		vendor: resource.Vendor_ALIYUN,
+		columns: map[string][]string{
+			columnMonth:        {"账期", "BillingCycle", "Billing Cycle"},
+			columnCid:          {"实例ID", "InstanceID", "Instance ID"},
+			columnInstanceName: {"实例昵称", "NickName", "Instance Nickname"},
+			columnProduct:      {"产品", "ProductName", "Product"},
+			columnRegion:       {"地域", "Region"},
+			columnPayType:      {"消费类型", "SubscriptionType", "Subscription Type"},
+			columnCurrency:     {"币种", "Currency"},
+			columnAmount:       {"应付金额", "PretaxAmount", "Pretax Amount"},
+		},
+	})
+	RegistryParser(&csvParser{
+		vendor: resource.Vendor_TENCENT,
+		columns: map[string][]string{
+			columnMonth:        {"账单月份", "BillMonth", "Billing Month"},
+			columnCid:          {"资源ID", "ResourceId", "Resource ID"},
+			columnInstanceName: {"实例名称", "ResourceName", "Resource Name"},
+			columnProduct:      {"产品名称", "BusinessCodeName", "Product Name"},
+			columnRegion:       {"地域", "RegionName", "Region"},
+			columnPayType:      {"计费模式", "PayModeName", "Billing Mode"},
+			columnCurrency:     {"币种", "Currency"},
+			columnAmount:       {"原价", "应付金额", "RealTotalCost", "Total Cost"},
+		},
+	})
+	RegistryParser(&csvParser{
+		vendor: resource.Vendor_HUAWEI,
+		columns: map[string][]string{
+			columnMonth:        {"账期", "BillCycle", "Billing Cycle"},
+			columnCid:          {"资源ID", "ResourceId", "Resource ID"},
+			columnInstanceName: {"资源名称", "ResourceName", "Resource Name"},
+			columnProduct:      {"产品类型", "CloudServiceTypeName", "Product Type"},
+			columnRegion:       {"区域", "RegionName", "Region"},
+			columnPayType:      {"计费模式", "ChargeMode", "Billing Mode"},
+			columnCurrency:     {"币种", "Currency"},
+			columnAmount:       {"应付金额", "OfficialAmount", "Amount Due"},
+		},
+	})
+	RegistryParser(&csvParser{
+		vendor: resource.Vendor_IDC,
+		columns: map[string][]string{
+			columnMonth:        {"month"},
+			columnCid:          {"c_id"},
+			columnInstanceName: {"name"},
+			columnProduct:      {"product"},
+			columnRegion:       {"region"},
+			columnPayType:      {"pay_type"},
+			columnCurrency:     {"currency"},
+			columnAmount:       {"amount"},
+		},
+	})
+}
@@ -0,0 +1,70 @@
+package bill_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opengoats/cmdb/apps/bill"
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+func TestAliyunParser(t *testing.T) {
+	should := assert.New(t)
+
+	p, err := bill.GetParser(resource.Vendor_ALIYUN)
+	should.NoError(err)
+
+	data := "\ufeff账期,产品,实例ID,实例昵称,地域,消费类型,币种,应付金额\n" +
+		"2023-04,云服务器ECS,i-001,web-01,cn-hangzhou,Subscription,CNY,\"1,200.50\"\n" +
+		"\n" +
+		"2023-04,云数据库RDS,rm-001,db-01,cn-hangzhou,PayAsYouGo,CNY,¥30\n"
+	bills, err := p.Parse(strings.NewReader(data))
+	should.NoError(err)
+	should.Len(bills, 2)
+	should.Equal("i-001", bills[0].Cid)
+	should.Equal("web-01", bills[0].InstanceName)
+	should.Equal("2023-04", bills[0].Month)
+	should.Equal(1200.5, bills[0].Amount)
+	should.Equal(float64(30), bills[1].Amount)
+
+	// 缺少必须字段
+	_, err = p.Parse(strings.NewReader("账期,产品\n2023-04,ECS\n"))
+	should.Error(err)
+}
+
+func TestNormalizeMonth(t *testing.T) {
+	should := assert.New(t)
+
+	should.Equal("2023-04", bill.NormalizeMonth("2023-04"))
+	should.Equal("2023-04", bill.NormalizeMonth("2023/04"))
+	should.Equal("2023-04", bill.NormalizeMonth("202304"))
+	should.Equal("2023-04", bill.NormalizeMonth("2023-4"))
+	should.Equal("2023-04", bill.NormalizeMonth("2023年4月"))
+}
+
+func TestImportBillPrepare(t *testing.T) {
+	should := assert.New(t)
+
+	req := bill.NewImportBillRequest(resource.Vendor_ALIYUN, "account-01", "2023-04", []byte("x"))
+	should.NoError(req.Validate())
+
+	bills := []*bill.Bill{{Cid: "i-001", Amount: 10}, {Cid: "i-002", Month: "2023-04", Amount: 20}}
+	should.NoError(req.Prepare(bills))
+	should.Equal("account-01", bills[0].Account)
+	should.Equal("2023-04", bills[0].Month)
+	should.NotEmpty(bills[0].Id)
+
+	// 文件中的月份与导入的月份不一致
+	should.Error(req.Prepare([]*bill.Bill{{Cid: "i-003", Month: "2023-05"}}))
+
+	bills[0].ResourceId = "r-001"
+	result := bill.NewImportBillResult(req)
+	for i := range bills {
+		result.Add(bills[i])
+	}
+	should.Equal(int64(2), result.Total)
+	should.Equal(int64(1), result.Matched)
+	should.Equal(float64(30), result.Amount)
+}
@@ -4,6 +4,8 @@ import (
 	restfulspec "github.com/emicklei/go-restful-openapi"
 	"github.com/emicklei/go-restful/v3"
 	"github.com/opengoats/cmdb/apps/book"
+	"github.com/opengoats/cmdb/common/auth"
+	"github.com/opengoats/cmdb/common/version"
 	"github.com/opengoats/goat/app"
 	"github.com/opengoats/goat/http/response"
 	"github.com/opengoats/goat/logger"
@@ -44,6 +46,12 @@ func (h *handler) Registry(ws *restful.WebService) {
 
 	ws.Route(ws.GET("/").To(h.QueryBook).
 		Doc("get all books").
+		Param(ws.QueryParameter("page_size", "page size").DataType("integer").DefaultValue("20")).
+		Param(ws.QueryParameter("page_number", "page number").DataType("integer").DefaultValue("1")).
+		Param(ws.QueryParameter("kws", "book name or author prefix").DataType("string")).
+		Param(ws.QueryParameter("sort_by", "sort field").DataType("string").PossibleValues([]string{"CREATE_AT", "BOOK_NAME", "AUTHOR"}).DefaultValue("CREATE_AT")).
+		Param(ws.QueryParameter("sort_order", "sort direction").DataType("string").PossibleValues([]string{"DESC", "ASC"}).DefaultValue("DESC")).
+		Param(ws.QueryParameter("include_deleted", "include deleted books").DataType("boolean")).
 		Metadata(restfulspec.KeyOpenAPITags, tags).
 		Metadata("action", "list").
 		Reads(book.CreateBookRequest{}).
@@ -61,19 +69,67 @@ func (h *handler) Registry(ws *restful.WebService) {
 	ws.Route(ws.PUT("/{id}").To(h.PutBook).
 		Doc("update a book").
 		Param(ws.PathParameter("id", "identifier of the book").DataType("string")).
+		Param(ws.HeaderParameter(version.IfMatchHeader, "expected book version, e.g. \"3\", fail with 412 if the book has been modified").DataType("string")).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, user or app tag of the caller is recorded as update_by").DataType("string")).
 		Metadata(restfulspec.KeyOpenAPITags, tags).
-		Reads(book.CreateBookRequest{}))
+		Reads(book.CreateBookRequest{}).
+		Returns(200, "OK", book.Book{}).
+		Returns(412, "Precondition Failed", nil))
 
 	ws.Route(ws.PATCH("/{id}").To(h.PatchBook).
 		Doc("patch a book").
 		Param(ws.PathParameter("id", "identifier of the book").DataType("string")).
+		Param(ws.HeaderParameter(version.IfMatchHeader, "expected book version, e.g. \"3\", fail with 412 if the book has been modified").DataType("string")).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, user or app tag of the caller is recorded as update_by").DataType("string")).
 		Metadata(restfulspec.KeyOpenAPITags, tags).
-		Reads(book.CreateBookRequest{}))
+		Reads(book.CreateBookRequest{}).
+		Returns(200, "OK", book.Book{}).
+		Returns(412, "Precondition Failed", nil))
 
 	ws.Route(ws.DELETE("/{id}").To(h.DeleteBook).
 		Doc("delete a book").
 		Metadata(restfulspec.KeyOpenAPITags, tags).
-		Param(ws.PathParameter("id", "identifier of the book").DataType("string")))
+		Param(ws.PathParameter("id", "identifier of the book").DataType("string")).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, user or app tag of the caller is recorded as delete_by").DataType("string")).
+		Returns(412, "Precondition Failed", nil))
+
+	ws.Route(ws.POST("/{id}/restore").To(h.RestoreBook).
+		Doc("restore a deleted book").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Param(ws.PathParameter("id", "identifier of the book").DataType("string")).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, user or app tag of the caller is recorded as update_by").DataType("string")).
+		Writes(response.NewMessage(book.Book{})).
+		Returns(200, "OK", book.Book{}).
+		Returns(400, "Bad Request", nil).
+		Returns(404, "Not Found", nil))
+
+	// 批量接口按条目返回结果, 条目失败不影响HTTP状态码
+	ws.Route(ws.POST("/batch/create").To(h.BatchCreateBooks).
+		Doc("create books in batch").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, user or app tag of the caller is recorded as create_by").DataType("string")).
+		Reads(book.BatchCreateBooksRequest{}).
+		Writes(response.NewMessage(book.BatchResult{})).
+		Returns(200, "OK", book.BatchResult{}).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.POST("/batch/update").To(h.BatchUpdateBooks).
+		Doc("update books in batch").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, user or app tag of the caller is recorded as update_by").DataType("string")).
+		Reads(book.BatchUpdateBooksRequest{}).
+		Writes(response.NewMessage(book.BatchResult{})).
+		Returns(200, "OK", book.BatchResult{}).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.POST("/batch/delete").To(h.BatchDeleteBooks).
+		Doc("delete books in batch").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, user or app tag of the caller is recorded as delete_by").DataType("string")).
+		Reads(book.BatchDeleteBooksRequest{}).
+		Writes(response.NewMessage(book.BatchResult{})).
+		Returns(200, "OK", book.BatchResult{}).
+		Returns(400, "Bad Request", nil))
 }
 
 func init() {
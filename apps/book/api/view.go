@@ -5,6 +5,8 @@ import (
 
 	"github.com/emicklei/go-restful/v3"
 	"github.com/opengoats/cmdb/apps/book"
+	"github.com/opengoats/cmdb/common/version"
+	"github.com/opengoats/goat/exception"
 	"github.com/opengoats/goat/http/response"
 )
 
@@ -29,7 +31,12 @@ func (h *handler) CreateBook(r *restful.Request, w *restful.Response) {
 func (h *handler) QueryBook(r *restful.Request, w *restful.Response) {
 
 	// 默认查询查询
-	req := book.NewQueryBookRequestFromHTTP(r.Request)
+	req, err := book.NewQueryBookRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("QueryBook").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse query book request error, %s", err))
+		return
+	}
 
 	// 数据查询
 	set, err := h.service.QueryBook(r.Request.Context(), req)
@@ -51,6 +58,7 @@ func (h *handler) DescribeBook(r *restful.Request, w *restful.Response) {
 		return
 	}
 
+	w.Header().Set(version.ETagHeader, version.NewETag(ins.Version))
 	response.Success(w.ResponseWriter, ins)
 }
 
@@ -62,13 +70,22 @@ func (h *handler) PutBook(r *restful.Request, w *restful.Response) {
 		response.Failed(w.ResponseWriter, fmt.Errorf("update error"))
 		return
 	}
+	expected, err := version.ParseIfMatch(r.Request)
+	if err != nil {
+		h.log.Named("PutBook").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse version error, %s", err))
+		return
+	}
+	req.ExpectedVersion = expected
 
+	// 版本冲突时返回412, 客户端需要重新查询后再更新
 	ins, err := h.service.UpdateBook(r.Request.Context(), req)
 	if err != nil {
 		h.log.Named("PutBook").Error(err)
-		response.Failed(w.ResponseWriter, fmt.Errorf("update error"))
+		response.Failed(w.ResponseWriter, err)
 		return
 	}
+	w.Header().Set(version.ETagHeader, version.NewETag(ins.Version))
 	response.Success(w.ResponseWriter, ins)
 }
 
@@ -80,13 +97,22 @@ func (h *handler) PatchBook(r *restful.Request, w *restful.Response) {
 		response.Failed(w.ResponseWriter, fmt.Errorf("update error"))
 		return
 	}
+	expected, err := version.ParseIfMatch(r.Request)
+	if err != nil {
+		h.log.Named("PatchBook").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse version error, %s", err))
+		return
+	}
+	req.ExpectedVersion = expected
 
+	// 版本冲突时返回412, 客户端需要重新查询后再更新
 	ins, err := h.service.UpdateBook(r.Request.Context(), req)
 	if err != nil {
 		h.log.Named("PatchBook").Error(err)
-		response.Failed(w.ResponseWriter, fmt.Errorf("update error"))
+		response.Failed(w.ResponseWriter, err)
 		return
 	}
+	w.Header().Set(version.ETagHeader, version.NewETag(ins.Version))
 	response.Success(w.ResponseWriter, ins)
 }
 
@@ -95,8 +121,68 @@ func (h *handler) DeleteBook(r *restful.Request, w *restful.Response) {
 	_, err := h.service.DeleteBook(r.Request.Context(), req)
 	if err != nil {
 		h.log.Named("DeleteBook").Error(err)
-		response.Failed(w.ResponseWriter, fmt.Errorf("delete error"))
+		response.Failed(w.ResponseWriter, err)
 		return
 	}
 	response.Success(w.ResponseWriter, "create success")
 }
+
+func (h *handler) RestoreBook(r *restful.Request, w *restful.Response) {
+	req := book.NewRestoreBookRequest(r.PathParameter("id"))
+	ins, err := h.service.RestoreBook(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("RestoreBook").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+	w.Header().Set(version.ETagHeader, version.NewETag(ins.Version))
+	response.Success(w.ResponseWriter, ins)
+}
+
+func (h *handler) BatchCreateBooks(r *restful.Request, w *restful.Response) {
+	req := book.NewBatchCreateBooksRequest()
+	if err := r.ReadEntity(req); err != nil {
+		h.log.Named("BatchCreateBooks").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read batch create books request error, %s", err))
+		return
+	}
+	set, err := h.service.BatchCreateBooks(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("BatchCreateBooks").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+	response.Success(w.ResponseWriter, set)
+}
+
+func (h *handler) BatchUpdateBooks(r *restful.Request, w *restful.Response) {
+	req := book.NewBatchUpdateBooksRequest()
+	if err := r.ReadEntity(req); err != nil {
+		h.log.Named("BatchUpdateBooks").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read batch update books request error, %s", err))
+		return
+	}
+	set, err := h.service.BatchUpdateBooks(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("BatchUpdateBooks").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+	response.Success(w.ResponseWriter, set)
+}
+
+func (h *handler) BatchDeleteBooks(r *restful.Request, w *restful.Response) {
+	req := book.NewBatchDeleteBooksRequest()
+	if err := r.ReadEntity(req); err != nil {
+		h.log.Named("BatchDeleteBooks").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read batch delete books request error, %s", err))
+		return
+	}
+	set, err := h.service.BatchDeleteBooks(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("BatchDeleteBooks").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+	response.Success(w.ResponseWriter, set)
+}
@@ -0,0 +1,112 @@
+package book
+
+import (
+	"errors"
+
+	"github.com/opengoats/goat/exception"
+)
+
+var (
+	// ErrBatchAborted 整批回滚时没有失败的条目使用的错误
+	ErrBatchAborted = exception.NewBadRequest("batch aborted, other items failed in %s mode", BatchMode_ALL_OR_NOTHING)
+)
+
+func (r *BatchCreateBooksRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+func (r *BatchUpdateBooksRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+func (r *BatchDeleteBooksRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+func NewBatchCreateBooksRequest() *BatchCreateBooksRequest {
+	return &BatchCreateBooksRequest{
+		Items: []*CreateBookRequest{},
+	}
+}
+
+func NewBatchUpdateBooksRequest() *BatchUpdateBooksRequest {
+	return &BatchUpdateBooksRequest{
+		Items: []*UpdateBookRequest{},
+	}
+}
+
+func NewBatchDeleteBooksRequest(ids ...string) *BatchDeleteBooksRequest {
+	return &BatchDeleteBooksRequest{
+		Ids: ids,
+	}
+}
+
+// NewBatchResult 每个条目一个结果, 默认成功, 处理失败时再标记
+func NewBatchResult(mode BatchMode, n int) *BatchResult {
+	s := &BatchResult{
+		Mode:  mode,
+		Items: make([]*BatchItemResult, 0, n),
+	}
+	for i := 0; i < n; i++ {
+		s.Items = append(s.Items, &BatchItemResult{Index: int64(i), Success: true})
+	}
+	return s
+}
+
+// HasFailed 是否有失败的条目
+func (s *BatchResult) HasFailed() bool {
+	for i := range s.Items {
+		if !s.Items[i].Success {
+			return true
+		}
+	}
+	return false
+}
+
+// Abort 整批回滚, 没有失败的条目标记为被回滚
+func (s *BatchResult) Abort() {
+	for i := range s.Items {
+		if s.Items[i].Success {
+			s.Items[i].Failed(ErrBatchAborted)
+		}
+	}
+	s.Committed = false
+	s.Count()
+}
+
+// Commit 事务提交后统计成功和失败的数量
+func (s *BatchResult) Commit() {
+	s.Count()
+	s.Committed = s.Succeeded > 0
+}
+
+// Count 统计成功和失败的数量
+func (s *BatchResult) Count() {
+	s.Succeeded, s.Failed = 0, 0
+	for i := range s.Items {
+		if s.Items[i].Success {
+			s.Succeeded++
+		} else {
+			s.Failed++
+		}
+	}
+}
+
+// Failed 使用exception中的错误码和原因, 其他错误作为内部错误
+func (r *BatchItemResult) Failed(err error) {
+	var e exception.APIException
+	if !errors.As(err, &e) {
+		e = exception.NewInternalServerError("%s", err)
+	}
+	r.Success = false
+	r.ErrorCode = int64(e.ErrorCode())
+	r.Reason = e.GetReason()
+	r.Message = e.Error()
+	r.Book = nil
+}
+
+// Succeed 处理成功, 返回处理后的书本
+func (r *BatchItemResult) Succeed(ins *Book) {
+	r.Success = true
+	r.Book = ins
+}
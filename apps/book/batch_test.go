@@ -0,0 +1,62 @@
+package book_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opengoats/cmdb/apps/book"
+	"github.com/opengoats/goat/exception"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchItemResultFailed(t *testing.T) {
+	should := assert.New(t)
+
+	set := book.NewBatchResult(book.BatchMode_BEST_EFFORT, 2)
+	set.Items[0].Succeed(book.NewBook())
+	set.Items[0].Failed(exception.NewNotFound("book %s not found", "b1"))
+	should.False(set.Items[0].Success)
+	should.Nil(set.Items[0].Book)
+	should.Equal(int64(http.StatusNotFound), set.Items[0].ErrorCode)
+	should.Equal(exception.NewNotFound("").GetReason(), set.Items[0].Reason)
+	should.Equal("book b1 not found", set.Items[0].Message)
+
+	// 不是exception中的错误作为内部错误
+	set.Items[1].Failed(fmt.Errorf("connection reset"))
+	should.Equal(int64(http.StatusInternalServerError), set.Items[1].ErrorCode)
+	should.Equal("connection reset", set.Items[1].Message)
+}
+
+func TestBatchResultCommitAndAbort(t *testing.T) {
+	should := assert.New(t)
+
+	set := book.NewBatchResult(book.BatchMode_BEST_EFFORT, 3)
+	should.False(set.HasFailed())
+	for i := range set.Items {
+		should.Equal(int64(i), set.Items[i].Index)
+	}
+
+	set.Items[1].Failed(exception.NewBadRequest("validate create book error"))
+	should.True(set.HasFailed())
+	set.Commit()
+	should.True(set.Committed)
+	should.Equal(int64(2), set.Succeeded)
+	should.Equal(int64(1), set.Failed)
+
+	// 整批回滚时没有失败的条目标记为被回滚, 保留原来的错误
+	set = book.NewBatchResult(book.BatchMode_ALL_OR_NOTHING, 3)
+	set.Items[1].Failed(exception.NewNotFound("book b1 not found"))
+	set.Abort()
+	should.False(set.Committed)
+	should.Equal(int64(0), set.Succeeded)
+	should.Equal(int64(3), set.Failed)
+	should.Equal(book.ErrBatchAborted.Error(), set.Items[0].Message)
+	should.Equal(int64(http.StatusNotFound), set.Items[1].ErrorCode)
+
+	// 没有成功的条目时没有提交任何数据
+	set = book.NewBatchResult(book.BatchMode_BEST_EFFORT, 1)
+	set.Items[0].Failed(exception.NewBadRequest("validate create book error"))
+	set.Commit()
+	should.False(set.Committed)
+}
@@ -0,0 +1,145 @@
+// Code generated by github.com/opengoats/goat
+// DO NOT EDIT
+
+package book
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseSortFieldFromString Parse SortField from string
+func ParseSortFieldFromString(str string) (SortField, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := SortField_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown SortField: %s", str)
+	}
+
+	return SortField(v), nil
+}
+
+// Equal type compare
+func (t SortField) Equal(target SortField) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t SortField) IsIn(targets ...SortField) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t SortField) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *SortField) UnmarshalJSON(b []byte) error {
+	ins, err := ParseSortFieldFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseSortOrderFromString Parse SortOrder from string
+func ParseSortOrderFromString(str string) (SortOrder, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := SortOrder_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown SortOrder: %s", str)
+	}
+
+	return SortOrder(v), nil
+}
+
+// Equal type compare
+func (t SortOrder) Equal(target SortOrder) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t SortOrder) IsIn(targets ...SortOrder) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t SortOrder) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *SortOrder) UnmarshalJSON(b []byte) error {
+	ins, err := ParseSortOrderFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseBatchModeFromString Parse BatchMode from string
+func ParseBatchModeFromString(str string) (BatchMode, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := BatchMode_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown BatchMode: %s", str)
+	}
+
+	return BatchMode(v), nil
+}
+
+// Equal type compare
+func (t BatchMode) Equal(target BatchMode) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t BatchMode) IsIn(targets ...BatchMode) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t BatchMode) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *BatchMode) UnmarshalJSON(b []byte) error {
+	ins, err := ParseBatchModeFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_CreateBook_FullMethodName   = "/book.Service/CreateBook"
-	Service_QueryBook_FullMethodName    = "/book.Service/QueryBook"
-	Service_DescribeBook_FullMethodName = "/book.Service/DescribeBook"
-	Service_UpdateBook_FullMethodName   = "/book.Service/UpdateBook"
-	Service_DeleteBook_FullMethodName   = "/book.Service/DeleteBook"
+	Service_CreateBook_FullMethodName       = "/book.Service/CreateBook"
+	Service_QueryBook_FullMethodName        = "/book.Service/QueryBook"
+	Service_DescribeBook_FullMethodName     = "/book.Service/DescribeBook"
+	Service_UpdateBook_FullMethodName       = "/book.Service/UpdateBook"
+	Service_DeleteBook_FullMethodName       = "/book.Service/DeleteBook"
+	Service_RestoreBook_FullMethodName      = "/book.Service/RestoreBook"
+	Service_BatchCreateBooks_FullMethodName = "/book.Service/BatchCreateBooks"
+	Service_BatchUpdateBooks_FullMethodName = "/book.Service/BatchUpdateBooks"
+	Service_BatchDeleteBooks_FullMethodName = "/book.Service/BatchDeleteBooks"
 )
 
 // ServiceClient is the client API for Service service.
@@ -35,6 +39,10 @@ type ServiceClient interface {
 	DescribeBook(ctx context.Context, in *DescribeBookRequest, opts ...grpc.CallOption) (*Book, error)
 	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*Book, error)
 	DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*Book, error)
+	RestoreBook(ctx context.Context, in *RestoreBookRequest, opts ...grpc.CallOption) (*Book, error)
+	BatchCreateBooks(ctx context.Context, in *BatchCreateBooksRequest, opts ...grpc.CallOption) (*BatchResult, error)
+	BatchUpdateBooks(ctx context.Context, in *BatchUpdateBooksRequest, opts ...grpc.CallOption) (*BatchResult, error)
+	BatchDeleteBooks(ctx context.Context, in *BatchDeleteBooksRequest, opts ...grpc.CallOption) (*BatchResult, error)
 }
 
 type serviceClient struct {
@@ -90,6 +98,42 @@ func (c *serviceClient) DeleteBook(ctx context.Context, in *DeleteBookRequest, o
 	return out, nil
 }
 
+func (c *serviceClient) RestoreBook(ctx context.Context, in *RestoreBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := c.cc.Invoke(ctx, Service_RestoreBook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BatchCreateBooks(ctx context.Context, in *BatchCreateBooksRequest, opts ...grpc.CallOption) (*BatchResult, error) {
+	out := new(BatchResult)
+	err := c.cc.Invoke(ctx, Service_BatchCreateBooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BatchUpdateBooks(ctx context.Context, in *BatchUpdateBooksRequest, opts ...grpc.CallOption) (*BatchResult, error) {
+	out := new(BatchResult)
+	err := c.cc.Invoke(ctx, Service_BatchUpdateBooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BatchDeleteBooks(ctx context.Context, in *BatchDeleteBooksRequest, opts ...grpc.CallOption) (*BatchResult, error) {
+	out := new(BatchResult)
+	err := c.cc.Invoke(ctx, Service_BatchDeleteBooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -99,6 +143,10 @@ type ServiceServer interface {
 	DescribeBook(context.Context, *DescribeBookRequest) (*Book, error)
 	UpdateBook(context.Context, *UpdateBookRequest) (*Book, error)
 	DeleteBook(context.Context, *DeleteBookRequest) (*Book, error)
+	RestoreBook(context.Context, *RestoreBookRequest) (*Book, error)
+	BatchCreateBooks(context.Context, *BatchCreateBooksRequest) (*BatchResult, error)
+	BatchUpdateBooks(context.Context, *BatchUpdateBooksRequest) (*BatchResult, error)
+	BatchDeleteBooks(context.Context, *BatchDeleteBooksRequest) (*BatchResult, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -121,6 +169,18 @@ func (UnimplementedServiceServer) UpdateBook(context.Context, *UpdateBookRequest
 func (UnimplementedServiceServer) DeleteBook(context.Context, *DeleteBookRequest) (*Book, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBook not implemented")
 }
+func (UnimplementedServiceServer) RestoreBook(context.Context, *RestoreBookRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBook not implemented")
+}
+func (UnimplementedServiceServer) BatchCreateBooks(context.Context, *BatchCreateBooksRequest) (*BatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateBooks not implemented")
+}
+func (UnimplementedServiceServer) BatchUpdateBooks(context.Context, *BatchUpdateBooksRequest) (*BatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateBooks not implemented")
+}
+func (UnimplementedServiceServer) BatchDeleteBooks(context.Context, *BatchDeleteBooksRequest) (*BatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteBooks not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -224,6 +284,78 @@ func _Service_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_RestoreBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RestoreBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_RestoreBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RestoreBook(ctx, req.(*RestoreBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BatchCreateBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BatchCreateBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_BatchCreateBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BatchCreateBooks(ctx, req.(*BatchCreateBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BatchUpdateBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BatchUpdateBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_BatchUpdateBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BatchUpdateBooks(ctx, req.(*BatchUpdateBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BatchDeleteBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BatchDeleteBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_BatchDeleteBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BatchDeleteBooks(ctx, req.(*BatchDeleteBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +383,22 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBook",
 			Handler:    _Service_DeleteBook_Handler,
 		},
+		{
+			MethodName: "RestoreBook",
+			Handler:    _Service_RestoreBook_Handler,
+		},
+		{
+			MethodName: "BatchCreateBooks",
+			Handler:    _Service_BatchCreateBooks_Handler,
+		},
+		{
+			MethodName: "BatchUpdateBooks",
+			Handler:    _Service_BatchUpdateBooks_Handler,
+		},
+		{
+			MethodName: "BatchDeleteBooks",
+			Handler:    _Service_BatchDeleteBooks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apps/book/pb/book.proto",
@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-playground/validator"
 	"github.com/imdario/mergo"
+	"github.com/opengoats/cmdb/common/version"
 	"github.com/opengoats/goat/http/request"
 	request1 "github.com/opengoats/goat/pb/request"
 	"github.com/rs/xid"
@@ -55,6 +56,14 @@ func (r *DeleteBookRequest) Validate() error {
 	}
 }
 
+func (r *RestoreBookRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
 func (r *Book) Validate() error {
 	if err := validate.Struct(r); err != nil {
 		return err
@@ -79,6 +88,7 @@ func NewBook() *Book {
 		Status:   1,
 		CreateAt: time.Now().UnixMicro(),
 		CreateBy: "",
+		Version:  1,
 	}
 }
 
@@ -86,12 +96,42 @@ func NewCreateBookRequest() *CreateBookRequest {
 	return &CreateBookRequest{}
 }
 
-func NewQueryBookRequestFromHTTP(r *http.Request) *QueryBookRequest {
+func NewQueryBookRequestFromHTTP(r *http.Request) (*QueryBookRequest, error) {
 	qs := r.URL.Query()
 
-	return &QueryBookRequest{
-		Page:     request.NewPageRequestFromHTTP(r),
-		Keywords: qs.Get("kws"),
+	req := &QueryBookRequest{
+		Page:           request.NewPageRequestFromHTTP(r),
+		Keywords:       qs.Get("kws"),
+		IncludeDeleted: qs.Get("include_deleted") == "true",
+	}
+
+	// 排序字段和方向, 忽略大小写
+	if v := qs.Get("sort_by"); v != "" {
+		sortBy, err := ParseSortFieldFromString(v)
+		if err != nil {
+			return nil, err
+		}
+		req.SortBy = sortBy
+	}
+	if v := qs.Get("sort_order"); v != "" {
+		sortOrder, err := ParseSortOrderFromString(v)
+		if err != nil {
+			return nil, err
+		}
+		req.SortOrder = sortOrder
+	}
+	return req, nil
+}
+
+// SortColumn 排序字段对应的数据库字段
+func (r *QueryBookRequest) SortColumn() string {
+	switch r.SortBy {
+	case SortField_BOOK_NAME:
+		return "book_name"
+	case SortField_AUTHOR:
+		return "author"
+	default:
+		return "create_at"
 	}
 }
 
@@ -149,3 +189,35 @@ func NewDeleteBookRequestWithID(id string) *DeleteBookRequest {
 		Id: id,
 	}
 }
+
+func NewRestoreBookRequest(id string) *RestoreBookRequest {
+	return &RestoreBookRequest{
+		Id: id,
+	}
+}
+
+// IsDeleted 是否已经删除
+func (b *Book) IsDeleted() bool {
+	return b.Status == 0
+}
+
+// Delete 软删除, 记录删除人和删除时间
+func (b *Book) Delete(by string) {
+	b.Status = 0
+	b.DeleteAt = time.Now().UnixMicro()
+	b.DeleteBy = by
+}
+
+// Restore 恢复已经删除的书本, 恢复记录为一次更新
+func (b *Book) Restore(by string) {
+	b.Status = 2
+	b.DeleteAt = 0
+	b.DeleteBy = ""
+	b.UpdateAt = time.Now().UnixMicro()
+	b.UpdateBy = by
+}
+
+// CheckVersion 期望的版本号为0时不校验
+func (b *Book) CheckVersion(expected int64) error {
+	return version.Check("book", b.Id, b.Version, expected)
+}
@@ -0,0 +1,67 @@
+package book_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opengoats/cmdb/apps/book"
+	"github.com/opengoats/goat/exception"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewQueryBookRequestFromHTTP(t *testing.T) {
+	should := assert.New(t)
+
+	req, err := book.NewQueryBookRequestFromHTTP(httptest.NewRequest("GET", "/?kws=go&sort_by=book_name&sort_order=asc&include_deleted=true", nil))
+	if should.NoError(err) {
+		should.Equal("go", req.Keywords)
+		should.Equal("book_name", req.SortColumn())
+		should.Equal(book.SortOrder_ASC, req.SortOrder)
+		should.True(req.IncludeDeleted)
+	}
+
+	// 默认按录入时间倒序
+	req, err = book.NewQueryBookRequestFromHTTP(httptest.NewRequest("GET", "/", nil))
+	if should.NoError(err) {
+		should.Equal("create_at", req.SortColumn())
+		should.Equal(book.SortOrder_DESC, req.SortOrder)
+		should.False(req.IncludeDeleted)
+	}
+
+	_, err = book.NewQueryBookRequestFromHTTP(httptest.NewRequest("GET", "/?sort_by=price", nil))
+	should.Error(err)
+}
+
+func TestBookDeleteAndRestore(t *testing.T) {
+	should := assert.New(t)
+
+	ins := book.NewBook()
+	ins.Delete("user:alice")
+	should.True(ins.IsDeleted())
+	should.NotZero(ins.DeleteAt)
+	should.Equal("user:alice", ins.DeleteBy)
+
+	ins.Restore("user:bob")
+	should.False(ins.IsDeleted())
+	should.Zero(ins.DeleteAt)
+	should.Empty(ins.DeleteBy)
+	should.Equal("user:bob", ins.UpdateBy)
+}
+
+func TestBookCheckVersion(t *testing.T) {
+	should := assert.New(t)
+
+	ins := book.NewBook()
+	should.Equal(int64(1), ins.Version)
+	should.NoError(ins.CheckVersion(0))
+	should.NoError(ins.CheckVersion(1))
+
+	err := ins.CheckVersion(2)
+	if should.Error(err) {
+		e, ok := err.(exception.APIException)
+		if should.True(ok) {
+			should.Equal(http.StatusPreconditionFailed, e.GetHttpCode())
+		}
+	}
+}
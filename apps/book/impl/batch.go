@@ -0,0 +1,386 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/opengoats/cmdb/apps/book"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/pb/request"
+)
+
+const (
+	// mysql唯一键冲突的错误码
+	errDuplicateEntry = 1062
+	// mysql数据超过列长度的错误码
+	errDataTooLong = 1406
+)
+
+var (
+	// 整批回滚时用于触发事务回滚
+	errRollback = errors.New("rollback batch")
+)
+
+// withTx 在一个事务中执行批量操作, fn返回错误时回滚, 提交失败时返回错误
+func (s *service) withTx(ctx context.Context, name string, fn func(tx *sql.Tx) error) (err error) {
+	// 开启一个事务
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		s.log.Named(name).Error(err)
+		return exception.NewInternalServerError("start tx err %s", err)
+	}
+
+	// 通过Defer处理事务提交方式
+	// 1. 无报错，则Commit 事务
+	// 2. 有报错，则Rollback 事务
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				s.log.Named(name).Error("rollback error ", err)
+			}
+		} else {
+			if err = tx.Commit(); err != nil {
+				s.log.Named(name).Error("commit error ", err)
+				err = exception.NewInternalServerError("commit tx err %s", err)
+			}
+		}
+	}()
+
+	return fn(tx)
+}
+
+// finishBatch 根据事务的执行结果更新批量结果, 事务失败时所有未失败的条目都失败
+func finishBatch(set *book.BatchResult, err error) (*book.BatchResult, error) {
+	switch {
+	case err == nil:
+		set.Commit()
+	case errors.Is(err, errRollback):
+		set.Abort()
+	default:
+		for i := range set.Items {
+			if set.Items[i].Success {
+				set.Items[i].Failed(err)
+			}
+		}
+		set.Abort()
+	}
+	return set, nil
+}
+
+// rollbackIfFailed 整批模式下有失败的条目时回滚
+func rollbackIfFailed(set *book.BatchResult) error {
+	if set.Mode == book.BatchMode_ALL_OR_NOTHING && set.HasFailed() {
+		return errRollback
+	}
+	return nil
+}
+
+// execItem 尽力模式下逐条写入, 每条语句在保存点中执行, 执行失败时只回滚该条目并标记失败
+// 返回的错误表示事务已经不可用, 需要回滚整个事务
+func (s *service) execItem(ctx context.Context, tx *sql.Tx, name string, item *book.BatchItemResult, query string, args ...interface{}) error {
+	// 同名的保存点会覆盖上一个, 不需要逐个释放
+	if _, err := tx.ExecContext(ctx, savepointBatchItem); err != nil {
+		s.log.Named(name).Error(err)
+		return exception.NewInternalServerError("savepoint err %s", err)
+	}
+
+	s.log.Named(name).Debugf("sql: %s; %v", query, args)
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		s.log.Named(name).Error(err)
+		item.Failed(newItemError(err))
+		if _, err := tx.ExecContext(ctx, rollbackBatchItem); err != nil {
+			s.log.Named(name).Error(err)
+			return exception.NewInternalServerError("rollback to savepoint err %s", err)
+		}
+	}
+	return nil
+}
+
+// newItemError 唯一键冲突和数据超长是条目本身的错误, 其他作为内部错误
+func newItemError(err error) error {
+	var e *mysql.MySQLError
+	if errors.As(err, &e) {
+		switch e.Number {
+		case errDuplicateEntry:
+			return exception.NewConflict("book already exists, %s", e.Message)
+		case errDataTooLong:
+			return exception.NewBadRequest("book data too long, %s", e.Message)
+		}
+	}
+	return exception.NewInternalServerError("write table book err %s", err)
+}
+
+func (s *service) batchCreate(ctx context.Context, req *book.BatchCreateBooksRequest) (*book.BatchResult, error) {
+	set := book.NewBatchResult(req.Mode, len(req.Items))
+
+	// 逐个校验, 校验失败的条目不写入
+	books, items := []*book.Book{}, []*book.BatchItemResult{}
+	for i := range req.Items {
+		if err := req.Items[i].Validate(); err != nil {
+			set.Items[i].Failed(exception.NewBadRequest("validate create book error, %s", err))
+			continue
+		}
+		ins := book.NewBook()
+		ins.CreateBy = req.CreateBy
+		ins.Data = req.Items[i]
+		set.Items[i].Id = ins.Id
+		set.Items[i].Succeed(ins)
+		books, items = append(books, ins), append(items, set.Items[i])
+	}
+
+	err := s.withTx(ctx, "BatchCreateBooks", func(tx *sql.Tx) error {
+		if err := rollbackIfFailed(set); err != nil {
+			return err
+		}
+		if len(books) == 0 {
+			return nil
+		}
+
+		// 尽力模式逐条写入, 单条写入失败不影响其他条目
+		if set.Mode == book.BatchMode_BEST_EFFORT {
+			for j, ins := range books {
+				err := s.execItem(ctx, tx, "BatchCreateBooks", items[j], insertBook,
+					ins.Id, ins.Status, ins.CreateAt, ins.CreateBy, ins.Data.BookName, ins.Data.Author, ins.Version)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		// 整批模式多行插入, 一条语句写入整个批次
+		args := make([]interface{}, 0, len(books)*7)
+		for _, ins := range books {
+			args = append(args, ins.Id, ins.Status, ins.CreateAt, ins.CreateBy, ins.Data.BookName, ins.Data.Author, ins.Version)
+		}
+		insertSQL := batchInsertBook + strings.TrimSuffix(strings.Repeat(batchInsertBookValues+",", len(books)), ",")
+		s.log.Named("BatchCreateBooks").Debugf("sql: %s; %v", insertSQL, args)
+		if _, err := tx.ExecContext(ctx, insertSQL, args...); err != nil {
+			s.log.Named("BatchCreateBooks").Error(err)
+			return exception.NewInternalServerError("insert table book err %s", err)
+		}
+		return nil
+	})
+	return finishBatch(set, err)
+}
+
+func (s *service) batchUpdate(ctx context.Context, req *book.BatchUpdateBooksRequest) (*book.BatchResult, error) {
+	set := book.NewBatchResult(req.Mode, len(req.Items))
+
+	ids := []string{}
+	for i, item := range req.Items {
+		set.Items[i].Id = item.Id
+		if err := item.Validate(); err != nil {
+			set.Items[i].Failed(exception.NewBadRequest("validate update book error, %s", err))
+			continue
+		}
+		if item.Data == nil {
+			set.Items[i].Failed(exception.NewBadRequest("validate update book error, data required"))
+			continue
+		}
+		ids = append(ids, item.Id)
+	}
+	markDuplicated(set)
+
+	err := s.withTx(ctx, "BatchUpdateBooks", func(tx *sql.Tx) error {
+		exists, err := s.lockBooks(ctx, tx, "BatchUpdateBooks", ids)
+		if err != nil {
+			return err
+		}
+
+		// 在已有数据上按更新模式合并, 合并后重新校验
+		books, items := []*book.Book{}, []*book.BatchItemResult{}
+		for i, item := range req.Items {
+			if !set.Items[i].Success {
+				continue
+			}
+			ins, ok := exists[item.Id]
+			if !ok {
+				set.Items[i].Failed(exception.NewNotFound("book %s not found", item.Id))
+				continue
+			}
+			if err := ins.CheckVersion(item.ExpectedVersion); err != nil {
+				set.Items[i].Failed(err)
+				continue
+			}
+			switch item.UpdateMode {
+			case request.UpdateMode_PATCH:
+				if err := ins.Patch(item); err != nil {
+					set.Items[i].Failed(exception.NewBadRequest("patch book error, %s", err))
+					continue
+				}
+			default:
+				ins.Update(item)
+			}
+			ins.UpdateBy = req.UpdateBy
+			if err := ins.Validate(); err != nil {
+				set.Items[i].Failed(exception.NewBadRequest("validate book error, %s", err))
+				continue
+			}
+			ins.Version++
+			set.Items[i].Succeed(ins)
+			books, items = append(books, ins), append(items, set.Items[i])
+		}
+		if err := rollbackIfFailed(set); err != nil {
+			return err
+		}
+		if len(books) == 0 {
+			return nil
+		}
+
+		// 尽力模式逐条写入, 单条写入失败不影响其他条目
+		if set.Mode == book.BatchMode_BEST_EFFORT {
+			upsertSQL := batchUpsertBook + batchUpsertBookValues + batchUpsertBookUpdate
+			for j, ins := range books {
+				err := s.execItem(ctx, tx, "BatchUpdateBooks", items[j], upsertSQL,
+					ins.Id, ins.Status, ins.CreateAt, ins.CreateBy, ins.UpdateAt, ins.UpdateBy, ins.Data.BookName, ins.Data.Author, ins.Version)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		// 整批模式多行写入, 主键冲突时更新, 书本已经加锁, 不会插入新的记录
+		args := make([]interface{}, 0, len(books)*9)
+		for _, ins := range books {
+			args = append(args, ins.Id, ins.Status, ins.CreateAt, ins.CreateBy, ins.UpdateAt, ins.UpdateBy, ins.Data.BookName, ins.Data.Author, ins.Version)
+		}
+		upsertSQL := batchUpsertBook + strings.TrimSuffix(strings.Repeat(batchUpsertBookValues+",", len(books)), ",") + batchUpsertBookUpdate
+		s.log.Named("BatchUpdateBooks").Debugf("sql: %s; %v", upsertSQL, args)
+		if _, err := tx.ExecContext(ctx, upsertSQL, args...); err != nil {
+			s.log.Named("BatchUpdateBooks").Error(err)
+			return exception.NewInternalServerError("update table book err %s", err)
+		}
+		return nil
+	})
+	return finishBatch(set, err)
+}
+
+func (s *service) batchDelete(ctx context.Context, req *book.BatchDeleteBooksRequest) (*book.BatchResult, error) {
+	set := book.NewBatchResult(req.Mode, len(req.Ids))
+
+	ids := []string{}
+	for i, id := range req.Ids {
+		set.Items[i].Id = id
+		if err := book.NewDeleteBookRequestWithID(id).Validate(); err != nil {
+			set.Items[i].Failed(exception.NewBadRequest("validate delete book error, %s", err))
+			continue
+		}
+		ids = append(ids, id)
+	}
+	markDuplicated(set)
+
+	err := s.withTx(ctx, "BatchDeleteBooks", func(tx *sql.Tx) error {
+		exists, err := s.lockBooks(ctx, tx, "BatchDeleteBooks", ids)
+		if err != nil {
+			return err
+		}
+
+		deleteAt := time.Now().UnixMicro()
+		deleted, items := []string{}, []*book.BatchItemResult{}
+		for i, id := range req.Ids {
+			if !set.Items[i].Success {
+				continue
+			}
+			ins, ok := exists[id]
+			if !ok {
+				set.Items[i].Failed(exception.NewNotFound("book %s not found", id))
+				continue
+			}
+			ins.Delete(req.DeleteBy)
+			ins.DeleteAt = deleteAt
+			ins.Version++
+			set.Items[i].Succeed(ins)
+			deleted, items = append(deleted, id), append(items, set.Items[i])
+		}
+		if err := rollbackIfFailed(set); err != nil {
+			return err
+		}
+		if len(deleted) == 0 {
+			return nil
+		}
+
+		// 尽力模式逐条删除, 单条删除失败不影响其他条目
+		if set.Mode == book.BatchMode_BEST_EFFORT {
+			deleteSQL := fmt.Sprintf(batchDeleteBook, "")
+			for j, id := range deleted {
+				if err := s.execItem(ctx, tx, "BatchDeleteBooks", items[j], deleteSQL, deleteAt, req.DeleteBy, id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		deleteSQL := fmt.Sprintf(batchDeleteBook, strings.Repeat(",?", len(deleted)-1))
+		args := []interface{}{deleteAt, req.DeleteBy}
+		for _, id := range deleted {
+			args = append(args, id)
+		}
+		s.log.Named("BatchDeleteBooks").Debugf("sql: %s; %v", deleteSQL, args)
+		if _, err := tx.ExecContext(ctx, deleteSQL, args...); err != nil {
+			s.log.Named("BatchDeleteBooks").Error(err)
+			return exception.NewInternalServerError("delete book err %s", err)
+		}
+		return nil
+	})
+	return finishBatch(set, err)
+}
+
+// lockBooks 在事务中查询并锁定没有删除的书本, 避免合并之后被其他请求修改
+func (s *service) lockBooks(ctx context.Context, tx *sql.Tx, name string, ids []string) (map[string]*book.Book, error) {
+	exists := map[string]*book.Book{}
+	if len(ids) == 0 {
+		return exists, nil
+	}
+
+	querySQL := fmt.Sprintf(lockBook, strings.Repeat(",?", len(ids)-1))
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	s.log.Named(name).Debugf("sql: %s; %v", querySQL, args)
+	rows, err := tx.QueryContext(ctx, querySQL, args...)
+	if err != nil {
+		s.log.Named(name).Error(err)
+		return nil, exception.NewInternalServerError("query table book err %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ins := book.NewDefaultBook()
+		err := rows.Scan(&ins.Id, &ins.Status, &ins.CreateAt, &ins.CreateBy, &ins.UpdateAt, &ins.UpdateBy,
+			&ins.DeleteAt, &ins.DeleteBy, &ins.Data.BookName, &ins.Data.Author, &ins.Version)
+		if err != nil {
+			s.log.Named(name).Error(err)
+			return nil, exception.NewInternalServerError("query table book err %s", err)
+		}
+		exists[ins.Id] = ins
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named(name).Error(err)
+		return nil, exception.NewInternalServerError("query table book err %s", err)
+	}
+	return exists, nil
+}
+
+// markDuplicated 同一个批次中重复的id只处理第一个
+func markDuplicated(set *book.BatchResult) {
+	seen := map[string]bool{}
+	for i := range set.Items {
+		item := set.Items[i]
+		if !item.Success {
+			continue
+		}
+		if seen[item.Id] {
+			item.Failed(exception.NewBadRequest("book %s duplicated in batch", item.Id))
+			continue
+		}
+		seen[item.Id] = true
+	}
+}
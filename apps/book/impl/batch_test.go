@@ -0,0 +1,102 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/opengoats/goat/logger/zap"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opengoats/cmdb/apps/book"
+)
+
+// fakeConn 模拟的数据库连接, 书名为dup的书本写入时返回唯一键冲突
+type fakeConn struct {
+	execs      []string
+	committed  bool
+	rolledBack bool
+}
+
+func (c *fakeConn) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *fakeConn) Driver() driver.Driver                        { return nil }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return c, nil }
+func (c *fakeConn) Commit() error             { c.committed = true; return nil }
+func (c *fakeConn) Rollback() error           { c.rolledBack = true; return nil }
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.execs = append(c.execs, query)
+	if strings.HasPrefix(query, "INSERT") {
+		for _, arg := range args {
+			if arg.Value == "dup" {
+				return nil, &mysql.MySQLError{Number: errDuplicateEntry, Message: "Duplicate entry 'dup'"}
+			}
+		}
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func newTestService(conn *fakeConn) *service {
+	return &service{
+		db:  sql.OpenDB(conn),
+		log: zap.L().Named(book.AppName),
+	}
+}
+
+func newTestBatchCreateRequest(mode book.BatchMode) *book.BatchCreateBooksRequest {
+	req := book.NewBatchCreateBooksRequest()
+	req.Mode = mode
+	for _, name := range []string{"go", "dup", "rust"} {
+		req.Items = append(req.Items, &book.CreateBookRequest{BookName: name, Author: "alice"})
+	}
+	return req
+}
+
+func TestBatchCreateBestEffortDBFailure(t *testing.T) {
+	should := assert.New(t)
+
+	conn := &fakeConn{}
+	set, err := newTestService(conn).batchCreate(context.Background(), newTestBatchCreateRequest(book.BatchMode_BEST_EFFORT))
+	if !should.NoError(err) {
+		return
+	}
+
+	// 只有写入失败的条目失败, 其他条目提交
+	should.True(set.Committed)
+	should.Equal(int64(2), set.Succeeded)
+	should.Equal(int64(1), set.Failed)
+	should.True(set.Items[0].Success)
+	should.False(set.Items[1].Success)
+	should.Equal(int64(http.StatusConflict), set.Items[1].ErrorCode)
+	should.True(set.Items[2].Success)
+
+	should.True(conn.committed)
+	should.Contains(conn.execs, rollbackBatchItem)
+}
+
+func TestBatchCreateAllOrNothingDBFailure(t *testing.T) {
+	should := assert.New(t)
+
+	conn := &fakeConn{}
+	set, err := newTestService(conn).batchCreate(context.Background(), newTestBatchCreateRequest(book.BatchMode_ALL_OR_NOTHING))
+	if !should.NoError(err) {
+		return
+	}
+
+	// 整批写入失败, 所有条目失败
+	should.False(set.Committed)
+	should.Equal(int64(0), set.Succeeded)
+	should.Equal(int64(3), set.Failed)
+	should.True(conn.rolledBack)
+	should.False(conn.committed)
+}
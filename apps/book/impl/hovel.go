@@ -2,10 +2,14 @@ package impl
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/opengoats/cmdb/apps/book"
+	"github.com/opengoats/cmdb/common/auth"
+	"github.com/opengoats/cmdb/common/version"
 	"github.com/opengoats/goat/exception"
 	"github.com/opengoats/goat/pb/request"
+	"github.com/opengoats/goat/sqlbuilder"
 )
 
 func (s *service) save(ctx context.Context, ins *book.Book) (*book.Book, error) {
@@ -39,7 +43,7 @@ func (s *service) save(ctx context.Context, ins *book.Book) (*book.Book, error)
 	}
 	defer rstmt.Close()
 
-	_, err = rstmt.ExecContext(ctx, ins.Id, ins.Status, ins.CreateAt, ins.CreateBy, ins.Data.BookName, ins.Data.Author)
+	_, err = rstmt.ExecContext(ctx, ins.Id, ins.Status, ins.CreateAt, ins.CreateBy, ins.Data.BookName, ins.Data.Author, ins.Version)
 	if err != nil {
 		s.log.Named("CreateBook").Error(err)
 		return nil, exception.NewInternalServerError("insert table book err %s", err)
@@ -49,12 +53,35 @@ func (s *service) save(ctx context.Context, ins *book.Book) (*book.Book, error)
 }
 
 func (s *service) query(ctx context.Context, req *book.QueryBookRequest) (*book.BookSet, error) {
-	// 数据库插入参数
-	args := []interface{}{req.Keywords, req.Keywords, req.Page.ComputeOffset(), uint(req.Page.PageSize)}
+	// 构建查询条件, 默认不返回已经删除的书本
+	query := sqlbuilder.NewQuery(queryBook)
+	query.Where("(book_name LIKE ? OR author LIKE ?)", req.Keywords, req.Keywords)
+	if !req.IncludeDeleted {
+		query.Where("status > 0")
+	}
+
+	set := book.NewBookSet()
+
+	// total统计, 需要在分页之前构建
+	countSQL, args := query.BuildFromNewBase(countBook)
+	s.log.Named("QueryBook").Debugf("sql: %s; %v", countSQL, args)
+	if err := s.db.QueryRowContext(ctx, countSQL, args...).Scan(&set.Total); err != nil {
+		s.log.Named("QueryBook").Error(err)
+		return nil, exception.NewInternalServerError("count table book err %s", err)
+	}
+
+	// 排序并分页
+	order := query.Order(req.SortColumn())
+	if req.SortOrder == book.SortOrder_ASC {
+		order.Asc()
+	} else {
+		order.Desc()
+	}
+	querySQL, args := query.Limit(req.Page.ComputeOffset(), uint(req.Page.PageSize)).Build()
 
 	// query stmt, 构建一个Prepare语句
-	s.log.Named("QueryBook").Debugf("sql: %s; %v", queryBook, args)
-	stmt, err := s.db.PrepareContext(ctx, queryBook)
+	s.log.Named("QueryBook").Debugf("sql: %s; %v", querySQL, args)
+	stmt, err := s.db.PrepareContext(ctx, querySQL)
 	if err != nil {
 		s.log.Named("QueryBook").Error(err)
 		return nil, exception.NewInternalServerError("query table book err %s", err)
@@ -69,11 +96,10 @@ func (s *service) query(ctx context.Context, req *book.QueryBookRequest) (*book.
 	defer rows.Close()
 
 	// 结构体赋值
-	set := book.NewBookSet()
 	for rows.Next() {
 		ins := book.NewDefaultBook()
 		err := rows.Scan(&ins.Id, &ins.Status, &ins.CreateAt, &ins.CreateBy, &ins.UpdateAt, &ins.UpdateBy,
-			&ins.DeleteAt, &ins.DeleteBy, &ins.Data.BookName, &ins.Data.Author)
+			&ins.DeleteAt, &ins.DeleteBy, &ins.Data.BookName, &ins.Data.Author, &ins.Version)
 
 		if err != nil {
 			s.log.Named("QueryBook").Error(err)
@@ -81,20 +107,25 @@ func (s *service) query(ctx context.Context, req *book.QueryBookRequest) (*book.
 		}
 		set.Add(ins)
 	}
-
-	// total统计
-	set.Total = int64(len(set.Items))
+	if err := rows.Err(); err != nil {
+		s.log.Named("QueryBook").Error(err)
+		return nil, exception.NewInternalServerError("query table book err %s", err)
+	}
 
 	return set, nil
 
 }
 
 func (s *service) describe(ctx context.Context, req *book.DescribeBookRequest) (*book.Book, error) {
-	args := []interface{}{req.Id}
+	query := sqlbuilder.NewQuery(queryBook).Where("id = ?", req.Id)
+	if !req.IncludeDeleted {
+		query.Where("status > 0")
+	}
+	querySQL, args := query.Build()
 
 	// query stmt, 构建一个Prepare语句
-	s.log.Named("DescribeBook").Debugf("sql: %s; %v", describeBook, args)
-	stmt, err := s.db.PrepareContext(ctx, describeBook)
+	s.log.Named("DescribeBook").Debugf("sql: %s; %v", querySQL, args)
+	stmt, err := s.db.PrepareContext(ctx, querySQL)
 	if err != nil {
 		return nil, exception.NewInternalServerError("describe book err %s", err)
 	}
@@ -104,16 +135,19 @@ func (s *service) describe(ctx context.Context, req *book.DescribeBookRequest) (
 	ins := book.NewDefaultBook()
 
 	err = stmt.QueryRowContext(ctx, args...).Scan(&ins.Id, &ins.Status, &ins.CreateAt, &ins.CreateBy, &ins.UpdateAt, &ins.UpdateBy,
-		&ins.DeleteAt, &ins.DeleteBy, &ins.Data.BookName, &ins.Data.Author)
+		&ins.DeleteAt, &ins.DeleteBy, &ins.Data.BookName, &ins.Data.Author, &ins.Version)
 
 	if err != nil {
-		s.log.Named("QueryBook").Error(err)
+		if err == sql.ErrNoRows {
+			return nil, exception.NewNotFound("book %s not found", req.Id)
+		}
+		s.log.Named("DescribeBook").Error(err)
 		return nil, exception.NewInternalServerError("describe book err %s", err)
 	}
 	return ins, nil
 }
 
-func (s *service) update(ctx context.Context, req *book.UpdateBookRequest, ins *book.Book) (*book.Book, error) {
+func (s *service) update(ctx context.Context, req *book.UpdateBookRequest, ins *book.Book) (result *book.Book, err error) {
 	// 根据更新模式进行数据库操作
 	switch req.UpdateMode {
 	case request.UpdateMode_PUT:
@@ -124,6 +158,8 @@ func (s *service) update(ctx context.Context, req *book.UpdateBookRequest, ins *
 			return nil, exception.NewInternalServerError("update book err %s", err)
 		}
 	}
+	// 外部请求以认证后的调用方作为更新人
+	ins.UpdateBy = auth.FromContext(ctx).Actor()
 
 	// 校验更新后数据合法性
 	if err := ins.Validate(); err != nil {
@@ -147,24 +183,36 @@ func (s *service) update(ctx context.Context, req *book.UpdateBookRequest, ins *
 				s.log.Error("rollback error, %s", err.Error())
 			}
 		} else {
-			if err := tx.Commit(); err != nil {
+			// 提交失败时通过返回值报告, 避免把没有写入的变更作为成功返回
+			if err = tx.Commit(); err != nil {
 				s.log.Error("commit error, %s", err.Error())
+				result, err = nil, exception.NewInternalServerError("commit tx err %s", err)
 			}
 		}
 	}()
 
 	s.log.Named("UpdateBook").Debugf("sql: %s", updateBook)
 	bookStmt, err := tx.PrepareContext(ctx, updateBook)
-	_, err = bookStmt.ExecContext(ctx, ins.UpdateAt, ins.UpdateBy, ins.Data.BookName, ins.Data.Author, ins.Id)
 	if err != nil {
 		return nil, exception.NewInternalServerError("update book err %s", err)
 	}
 	defer bookStmt.Close()
 
+	ret, err := bookStmt.ExecContext(ctx, ins.UpdateAt, ins.UpdateBy, ins.Data.BookName, ins.Data.Author, ins.Id, ins.Version)
+	if err != nil {
+		return nil, exception.NewInternalServerError("update book err %s", err)
+	}
+
+	// 查询之后被其他请求修改, 不覆盖其他请求的修改
+	if n, _ := ret.RowsAffected(); n == 0 {
+		return nil, version.NewConflict("book %s has been modified, version %d is outdated", ins.Id, ins.Version)
+	}
+	ins.Version++
+
 	return ins, nil
 }
 
-func (s *service) delete(ctx context.Context, req *book.DeleteBookRequest, ins *book.Book) (*book.Book, error) {
+func (s *service) delete(ctx context.Context, req *book.DeleteBookRequest, ins *book.Book) (result *book.Book, err error) {
 	// 开启一个事务
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -180,8 +228,10 @@ func (s *service) delete(ctx context.Context, req *book.DeleteBookRequest, ins *
 				s.log.Error("rollback error, %s", err.Error())
 			}
 		} else {
-			if err := tx.Commit(); err != nil {
+			// 提交失败时通过返回值报告, 避免把没有写入的变更作为成功返回
+			if err = tx.Commit(); err != nil {
 				s.log.Error("commit error, %s", err.Error())
+				result, err = nil, exception.NewInternalServerError("commit tx err %s", err)
 			}
 		}
 	}()
@@ -193,10 +243,42 @@ func (s *service) delete(ctx context.Context, req *book.DeleteBookRequest, ins *
 	}
 	defer bookStmt.Close()
 
-	_, err = bookStmt.ExecContext(ctx, req.Id)
+	// 记录删除人和删除时间
+	ins.Delete(req.DeleteBy)
+	ret, err := bookStmt.ExecContext(ctx, ins.DeleteAt, ins.DeleteBy, req.Id, ins.Version)
 	if err != nil {
 		return nil, exception.NewInternalServerError("delete book err %s", err)
 	}
 
+	// 查询之后被其他请求修改或者删除, 不返回虚构的版本号
+	if n, _ := ret.RowsAffected(); n == 0 {
+		return nil, version.NewConflict("book %s has been modified or deleted, version %d is outdated", ins.Id, ins.Version)
+	}
+	ins.Version++
+
+	return ins, nil
+}
+
+func (s *service) restore(ctx context.Context, req *book.RestoreBookRequest, ins *book.Book) (*book.Book, error) {
+	ins.Restore(req.RestoreBy)
+
+	s.log.Named("RestoreBook").Debugf("sql: %s", restoreBook)
+	stmt, err := s.db.PrepareContext(ctx, restoreBook)
+	if err != nil {
+		return nil, exception.NewInternalServerError("restore book err %s", err)
+	}
+	defer stmt.Close()
+
+	ret, err := stmt.ExecContext(ctx, ins.Status, ins.UpdateAt, ins.UpdateBy, req.Id)
+	if err != nil {
+		return nil, exception.NewInternalServerError("restore book err %s", err)
+	}
+
+	// 并发恢复时只有一个请求成功
+	if n, _ := ret.RowsAffected(); n == 0 {
+		return nil, exception.NewBadRequest("book %s is not deleted", req.Id)
+	}
+	ins.Version++
+
 	return ins, nil
 }
@@ -1,13 +1,36 @@
 package impl
 
 const (
-	insertBook = `INSERT INTO books(id,status,create_at,create_by,book_name,author) VALUES (?,?,?,?,?,?);`
+	insertBook = `INSERT INTO books(id,status,create_at,create_by,book_name,author,version) VALUES (?,?,?,?,?,?,?);`
 
-	queryBook = `SELECT * FROM books Where status > 0 AND (book_name Like ? OR author Like ?) LIMIT ?,?`
+	queryBook = `SELECT id,status,create_at,create_by,update_at,update_by,delete_at,delete_by,book_name,author,version FROM books`
 
-	describeBook = `SELECT * FROM books Where status > 0 AND id = ?`
+	countBook = `SELECT COUNT(*) FROM books`
 
-	updateBook = `UPDATE books SET update_at=?,update_by=?,book_name=?,author=? WHERE id =?`
+	// 使用读取时的版本号更新, 期间被其他请求修改时不会更新任何记录
+	updateBook = `UPDATE books SET update_at=?,update_by=?,book_name=?,author=?,version=version+1 WHERE id =? AND version = ?`
 
-	deleteBook = `UPDATE books SET status=0 WHERE id = ?`
+	// 已经删除或者读取之后被其他请求修改时不会更新任何记录
+	deleteBook = `UPDATE books SET status=0,delete_at=?,delete_by=?,version=version+1 WHERE id = ? AND status > 0 AND version = ?`
+
+	restoreBook = `UPDATE books SET status=?,delete_at=0,delete_by='',update_at=?,update_by=?,version=version+1 
+	WHERE id = ? AND status = 0`
+
+	batchInsertBook       = `INSERT INTO books(id,status,create_at,create_by,book_name,author,version) VALUES `
+	batchInsertBookValues = `(?,?,?,?,?,?,?)`
+
+	// 批量更新使用多行写入, 主键冲突时更新
+	batchUpsertBook       = `INSERT INTO books(id,status,create_at,create_by,update_at,update_by,book_name,author,version) VALUES `
+	batchUpsertBookValues = `(?,?,?,?,?,?,?,?,?)`
+	batchUpsertBookUpdate = ` ON DUPLICATE KEY UPDATE status=VALUES(status),update_at=VALUES(update_at),
+	update_by=VALUES(update_by),book_name=VALUES(book_name),author=VALUES(author),version=VALUES(version)`
+
+	batchDeleteBook = `UPDATE books SET status=0,delete_at=?,delete_by=?,version=version+1 WHERE id IN (?%s) AND status > 0`
+
+	// 尽力模式下每个条目使用一个保存点, 条目写入失败时只回滚该条目
+	savepointBatchItem = `SAVEPOINT batch_item`
+	rollbackBatchItem  = `ROLLBACK TO SAVEPOINT batch_item`
+
+	lockBook = `SELECT id,status,create_at,create_by,update_at,update_by,delete_at,delete_by,book_name,author,version 
+	FROM books WHERE id IN (?%s) AND status > 0 FOR UPDATE`
 )
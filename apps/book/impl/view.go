@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/opengoats/cmdb/apps/book"
+	"github.com/opengoats/cmdb/common/auth"
 	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/request"
 )
 
 func (s *service) CreateBook(ctx context.Context, req *book.CreateBookRequest) (*book.Book, error) {
@@ -29,6 +31,9 @@ func (s *service) QueryBook(ctx context.Context, req *book.QueryBookRequest) (*b
 	}
 
 	// 查询默认值填充
+	if req.Page == nil {
+		req.Page = request.NewDefaultPageRequest()
+	}
 	if req.Keywords == "" {
 		req.Keywords = "%"
 	} else {
@@ -63,6 +68,10 @@ func (s *service) UpdateBook(ctx context.Context, req *book.UpdateBookRequest) (
 	if err != nil {
 		return nil, exception.NewBadRequest("id not exist, %s", err)
 	}
+	// 与期望的版本号不一致时不更新
+	if err := ins.CheckVersion(req.ExpectedVersion); err != nil {
+		return nil, err
+	}
 	// 数据库更新
 	return s.update(ctx, req, ins)
 }
@@ -74,6 +83,11 @@ func (s *service) DeleteBook(ctx context.Context, req *book.DeleteBookRequest) (
 		return nil, exception.NewBadRequest("validate delete book error, %s", err)
 	}
 
+	// 外部请求以认证后的调用方作为删除人
+	if id := auth.FromContext(ctx); id != nil {
+		req.DeleteBy = id.Actor()
+	}
+
 	// 验证更新id,查询不到直接返回
 	ins, err := s.DescribeBook(ctx, &book.DescribeBookRequest{Id: req.Id})
 	if err != nil {
@@ -82,3 +96,76 @@ func (s *service) DeleteBook(ctx context.Context, req *book.DeleteBookRequest) (
 	// 数据库操作
 	return s.delete(ctx, req, ins)
 }
+
+func (s *service) RestoreBook(ctx context.Context, req *book.RestoreBookRequest) (*book.Book, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("RestoreBook").Error(err)
+		return nil, exception.NewBadRequest("validate restore book error, %s", err)
+	}
+
+	// 外部请求以认证后的调用方作为恢复人
+	if id := auth.FromContext(ctx); id != nil {
+		req.RestoreBy = id.Actor()
+	}
+
+	// 包含已经删除的书本, 只允许恢复已经删除的
+	ins, err := s.describe(ctx, &book.DescribeBookRequest{Id: req.Id, IncludeDeleted: true})
+	if err != nil {
+		return nil, err
+	}
+	if !ins.IsDeleted() {
+		return nil, exception.NewBadRequest("book %s is not deleted", req.Id)
+	}
+
+	// 数据库操作
+	return s.restore(ctx, req, ins)
+}
+
+func (s *service) BatchCreateBooks(ctx context.Context, req *book.BatchCreateBooksRequest) (*book.BatchResult, error) {
+	// 请求体校验, 条目在批量处理中逐个校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("BatchCreateBooks").Error(err)
+		return nil, exception.NewBadRequest("validate batch create books error, %s", err)
+	}
+
+	// 外部请求以认证后的调用方作为创建人
+	if id := auth.FromContext(ctx); id != nil {
+		req.CreateBy = id.Actor()
+	}
+
+	// 数据库操作
+	return s.batchCreate(ctx, req)
+}
+
+func (s *service) BatchUpdateBooks(ctx context.Context, req *book.BatchUpdateBooksRequest) (*book.BatchResult, error) {
+	// 请求体校验, 条目在批量处理中逐个校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("BatchUpdateBooks").Error(err)
+		return nil, exception.NewBadRequest("validate batch update books error, %s", err)
+	}
+
+	// 外部请求以认证后的调用方作为更新人
+	if id := auth.FromContext(ctx); id != nil {
+		req.UpdateBy = id.Actor()
+	}
+
+	// 数据库操作
+	return s.batchUpdate(ctx, req)
+}
+
+func (s *service) BatchDeleteBooks(ctx context.Context, req *book.BatchDeleteBooksRequest) (*book.BatchResult, error) {
+	// 请求体校验, 条目在批量处理中逐个校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("BatchDeleteBooks").Error(err)
+		return nil, exception.NewBadRequest("validate batch delete books error, %s", err)
+	}
+
+	// 外部请求以认证后的调用方作为删除人
+	if id := auth.FromContext(ctx); id != nil {
+		req.DeleteBy = id.Actor()
+	}
+
+	// 数据库操作
+	return s.batchDelete(ctx, req)
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/cost"
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/http/response"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service cost.ServiceServer
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(cost.AppName)
+	h.service = app.GetGrpcApp(cost.AppName).(cost.ServiceServer)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return cost.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"costs"}
+
+	ws.Route(ws.GET("/allocations").To(h.Allocate).
+		Doc("allocate resource cost by tag weight").
+		Param(ws.QueryParameter("period", "billing period, e.g. 2023-04").DataType("string").Required(true)).
+		Param(ws.QueryParameter("tag_key", "tag key to allocate by, e.g. app").DataType("string").Required(true)).
+		Param(ws.QueryParameter("vendor", "resource vendor").DataType("string")).
+		Param(ws.QueryParameter("currency", "bill currency, required when the period has bills in multiple currencies").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(response.NewMessage(cost.AllocationSet{})).
+		Returns(200, "OK", cost.AllocationSet{}).
+		Returns(400, "Bad Request", nil))
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/cost"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/response"
+)
+
+func (h *handler) Allocate(r *restful.Request, w *restful.Response) {
+	// 加载查询条件
+	req, err := cost.NewAllocateRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("Allocate").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse allocate request error, %s", err))
+		return
+	}
+
+	set, err := h.service.Allocate(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("Allocate").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, set)
+}
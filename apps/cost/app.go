@@ -0,0 +1,5 @@
+package cost
+
+const (
+	AppName = "cost"
+)
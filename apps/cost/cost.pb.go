@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.22.0
+// source: apps/cost/pb/cost.proto
+
+package cost
+
+import (
+	resource "github.com/opengoats/cmdb/apps/resource"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AllocateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 账单周期, 格式: 2006-01
+	// @gotags: json:"period" validate:"required"
+	Period string `protobuf:"bytes,1,opt,name=period,proto3" json:"period" validate:"required"`
+	// 分摊的标签key, 比如 app
+	// @gotags: json:"tag_key" validate:"required,lte=255"
+	TagKey string `protobuf:"bytes,2,opt,name=tag_key,json=tagKey,proto3" json:"tag_key" validate:"required,lte=255"`
+	// 厂商, 为空时统计所有厂商
+	// @gotags: json:"vendor"
+	Vendor *resource.Vendor `protobuf:"varint,3,opt,name=vendor,proto3,enum=opengoats.cmdb.resource.Vendor,oneof" json:"vendor"`
+	// 币种, 比如 CNY, 不同币种的费用不能相加, 账单周期内有多个币种时必须指定
+	// @gotags: json:"currency" validate:"lte=16"
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency" validate:"lte=16"`
+}
+
+func (x *AllocateRequest) Reset() {
+	*x = AllocateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_cost_pb_cost_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllocateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateRequest) ProtoMessage() {}
+
+func (x *AllocateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_cost_pb_cost_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateRequest.ProtoReflect.Descriptor instead.
+func (*AllocateRequest) Descriptor() ([]byte, []int) {
+	return file_apps_cost_pb_cost_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AllocateRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AllocateRequest) GetTagKey() string {
+	if x != nil {
+		return x.TagKey
+	}
+	return ""
+}
+
+func (x *AllocateRequest) GetVendor() resource.Vendor {
+	if x != nil && x.Vendor != nil {
+		return *x.Vendor
+	}
+	return resource.Vendor(0)
+}
+
+func (x *AllocateRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Allocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 标签的值
+	// @gotags: json:"value"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
+	// 分摊到的费用
+	// @gotags: json:"amount"
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount"`
+	// 参与分摊的资源数量
+	// @gotags: json:"resource_count"
+	ResourceCount int64 `protobuf:"varint,3,opt,name=resource_count,json=resourceCount,proto3" json:"resource_count"`
+}
+
+func (x *Allocation) Reset() {
+	*x = Allocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_cost_pb_cost_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Allocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Allocation) ProtoMessage() {}
+
+func (x *Allocation) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_cost_pb_cost_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Allocation.ProtoReflect.Descriptor instead.
+func (*Allocation) Descriptor() ([]byte, []int) {
+	return file_apps_cost_pb_cost_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Allocation) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Allocation) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Allocation) GetResourceCount() int64 {
+	if x != nil {
+		return x.ResourceCount
+	}
+	return 0
+}
+
+type AllocationSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 账单周期
+	// @gotags: json:"period"
+	Period string `protobuf:"bytes,1,opt,name=period,proto3" json:"period"`
+	// 分摊的标签key
+	// @gotags: json:"tag_key"
+	TagKey string `protobuf:"bytes,2,opt,name=tag_key,json=tagKey,proto3" json:"tag_key"`
+	// 总费用
+	// @gotags: json:"total"
+	Total float64 `protobuf:"fixed64,3,opt,name=total,proto3" json:"total"`
+	// 已分摊的费用
+	// @gotags: json:"allocated"
+	Allocated float64 `protobuf:"fixed64,4,opt,name=allocated,proto3" json:"allocated"`
+	// 未分摊的费用, 资源没有该key的成本标签, 或者账单没有关联到资源
+	// @gotags: json:"unallocated"
+	Unallocated float64 `protobuf:"fixed64,5,opt,name=unallocated,proto3" json:"unallocated"`
+	// 未分摊的资源数量
+	// @gotags: json:"unallocated_resource_count"
+	UnallocatedResourceCount int64 `protobuf:"varint,6,opt,name=unallocated_resource_count,json=unallocatedResourceCount,proto3" json:"unallocated_resource_count"`
+	// 按标签值分摊的费用, 按费用从高到低排序
+	// @gotags: json:"items"
+	Items []*Allocation `protobuf:"bytes,7,rep,name=items,proto3" json:"items"`
+	// 费用的币种
+	// @gotags: json:"currency"
+	Currency string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency"`
+}
+
+func (x *AllocationSet) Reset() {
+	*x = AllocationSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_cost_pb_cost_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllocationSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocationSet) ProtoMessage() {}
+
+func (x *AllocationSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_cost_pb_cost_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocationSet.ProtoReflect.Descriptor instead.
+func (*AllocationSet) Descriptor() ([]byte, []int) {
+	return file_apps_cost_pb_cost_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AllocationSet) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AllocationSet) GetTagKey() string {
+	if x != nil {
+		return x.TagKey
+	}
+	return ""
+}
+
+func (x *AllocationSet) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AllocationSet) GetAllocated() float64 {
+	if x != nil {
+		return x.Allocated
+	}
+	return 0
+}
+
+func (x *AllocationSet) GetUnallocated() float64 {
+	if x != nil {
+		return x.Unallocated
+	}
+	return 0
+}
+
+func (x *AllocationSet) GetUnallocatedResourceCount() int64 {
+	if x != nil {
+		return x.UnallocatedResourceCount
+	}
+	return 0
+}
+
+func (x *AllocationSet) GetItems() []*Allocation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AllocationSet) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+var File_apps_cost_pb_cost_proto protoreflect.FileDescriptor
+
+var file_apps_cost_pb_cost_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x74, 0x2f, 0x70, 0x62, 0x2f, 0x63,
+	0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6f, 0x70, 0x65, 0x6e, 0x67,
+	0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x63, 0x6f, 0x73, 0x74, 0x1a, 0x1f,
+	0x61, 0x70, 0x70, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x70, 0x62,
+	0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xa7, 0x01, 0x0a, 0x0f, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x61, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
+	0x67, 0x4b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73,
+	0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x22, 0x61, 0x0a, 0x0a, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa7, 0x02, 0x0a,
+	0x0d, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x67, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x67, 0x4b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x1a, 0x75, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x75, 0x6e, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63,
+	0x6d, 0x64, 0x62, 0x2e, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x32, 0x5f, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x54, 0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x63,
+	0x6f, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e,
+	0x63, 0x6d, 0x64, 0x62, 0x2e, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2f,
+	0x63, 0x6d, 0x64, 0x62, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_cost_pb_cost_proto_rawDescOnce sync.Once
+	file_apps_cost_pb_cost_proto_rawDescData = file_apps_cost_pb_cost_proto_rawDesc
+)
+
+func file_apps_cost_pb_cost_proto_rawDescGZIP() []byte {
+	file_apps_cost_pb_cost_proto_rawDescOnce.Do(func() {
+		file_apps_cost_pb_cost_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_cost_pb_cost_proto_rawDescData)
+	})
+	return file_apps_cost_pb_cost_proto_rawDescData
+}
+
+var file_apps_cost_pb_cost_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_apps_cost_pb_cost_proto_goTypes = []interface{}{
+	(*AllocateRequest)(nil), // 0: opengoats.cmdb.cost.AllocateRequest
+	(*Allocation)(nil),      // 1: opengoats.cmdb.cost.Allocation
+	(*AllocationSet)(nil),   // 2: opengoats.cmdb.cost.AllocationSet
+	(resource.Vendor)(0),    // 3: opengoats.cmdb.resource.Vendor
+}
+var file_apps_cost_pb_cost_proto_depIdxs = []int32{
+	3, // 0: opengoats.cmdb.cost.AllocateRequest.vendor:type_name -> opengoats.cmdb.resource.Vendor
+	1, // 1: opengoats.cmdb.cost.AllocationSet.items:type_name -> opengoats.cmdb.cost.Allocation
+	0, // 2: opengoats.cmdb.cost.Service.Allocate:input_type -> opengoats.cmdb.cost.AllocateRequest
+	2, // 3: opengoats.cmdb.cost.Service.Allocate:output_type -> opengoats.cmdb.cost.AllocationSet
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_apps_cost_pb_cost_proto_init() }
+func file_apps_cost_pb_cost_proto_init() {
+	if File_apps_cost_pb_cost_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_cost_pb_cost_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllocateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_cost_pb_cost_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Allocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_cost_pb_cost_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllocationSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_apps_cost_pb_cost_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_cost_pb_cost_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_apps_cost_pb_cost_proto_goTypes,
+		DependencyIndexes: file_apps_cost_pb_cost_proto_depIdxs,
+		MessageInfos:      file_apps_cost_pb_cost_proto_msgTypes,
+	}.Build()
+	File_apps_cost_pb_cost_proto = out.File
+	file_apps_cost_pb_cost_proto_rawDesc = nil
+	file_apps_cost_pb_cost_proto_goTypes = nil
+	file_apps_cost_pb_cost_proto_depIdxs = nil
+}
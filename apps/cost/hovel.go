@@ -0,0 +1,140 @@
+package cost
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/go-playground/validator"
+
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+const (
+	// PeriodLayout 账单周期的格式
+	PeriodLayout = "2006-01"
+)
+
+var (
+	validate = validator.New()
+)
+
+func (r *AllocateRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+	if _, err := time.Parse(PeriodLayout, r.Period); err != nil {
+		return fmt.Errorf("period %s format must be %s", r.Period, PeriodLayout)
+	}
+	return nil
+}
+
+func NewAllocateRequest(period, tagKey string) *AllocateRequest {
+	return &AllocateRequest{
+		Period: period,
+		TagKey: tagKey,
+	}
+}
+
+func NewAllocateRequestFromHTTP(r *http.Request) (*AllocateRequest, error) {
+	qs := r.URL.Query()
+
+	req := NewAllocateRequest(qs.Get("period"), qs.Get("tag_key"))
+	req.Currency = qs.Get("currency")
+	if vd := qs.Get("vendor"); vd != "" {
+		v, err := resource.ParseVendorFromString(vd)
+		if err != nil {
+			return nil, err
+		}
+		req.Vendor = &v
+	}
+
+	return req, nil
+}
+
+// Allocator 按标签权重分摊资源费用
+type Allocator struct {
+	set   *AllocationSet
+	items map[string]*Allocation
+}
+
+func NewAllocator(req *AllocateRequest) *Allocator {
+	return &Allocator{
+		set: &AllocationSet{
+			Period:   req.Period,
+			TagKey:   req.TagKey,
+			Currency: req.Currency,
+			Items:    []*Allocation{},
+		},
+		items: map[string]*Allocation{},
+	}
+}
+
+// Add 分摊一个资源的费用, 只有该key下纳入成本统计的标签参与分摊, 按权重比例分摊, 权重默认为1
+// 没有参与分摊的标签时, 费用计入未分摊
+func (a *Allocator) Add(amount float64, tags []*resource.Tag) {
+	a.set.Total += amount
+
+	var total int64
+	costTags := []*resource.Tag{}
+	for i := range tags {
+		t := tags[i]
+		if t.Key != a.set.TagKey || !t.IsCost {
+			continue
+		}
+		costTags = append(costTags, t)
+		total += weight(t)
+	}
+
+	if len(costTags) == 0 {
+		a.set.Unallocated += amount
+		a.set.UnallocatedResourceCount++
+		return
+	}
+
+	for i := range costTags {
+		t := costTags[i]
+		item, ok := a.items[t.Value]
+		if !ok {
+			item = &Allocation{Value: t.Value}
+			a.items[t.Value] = item
+			a.set.Items = append(a.set.Items, item)
+		}
+		share := amount * float64(weight(t)) / float64(total)
+		item.Amount += share
+		item.ResourceCount++
+		a.set.Allocated += share
+	}
+}
+
+// SetCurrency 账单周期内费用的币种, 账单没有记录币种时使用请求中的币种
+func (a *Allocator) SetCurrency(currency string) {
+	if currency != "" {
+		a.set.Currency = currency
+	}
+}
+
+// AddUnmatched 账单没有关联到资源时, 只计入总费用和未分摊费用
+func (a *Allocator) AddUnmatched(amount float64) {
+	a.set.Total += amount
+	a.set.Unallocated += amount
+}
+
+// Result 返回分摊结果, 按费用从高到低排序
+func (a *Allocator) Result() *AllocationSet {
+	sort.SliceStable(a.set.Items, func(i, j int) bool {
+		if a.set.Items[i].Amount == a.set.Items[j].Amount {
+			return a.set.Items[i].Value < a.set.Items[j].Value
+		}
+		return a.set.Items[i].Amount > a.set.Items[j].Amount
+	})
+	return a.set
+}
+
+func weight(t *resource.Tag) int64 {
+	if t.Weight <= 0 {
+		return 1
+	}
+	return t.Weight
+}
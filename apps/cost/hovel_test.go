@@ -0,0 +1,51 @@
+package cost_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opengoats/cmdb/apps/cost"
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+func newTag(key, value string, weight int64, isCost bool) *resource.Tag {
+	return &resource.Tag{Key: key, Value: value, Weight: weight, IsCost: isCost}
+}
+
+func TestAllocator(t *testing.T) {
+	should := assert.New(t)
+
+	a := cost.NewAllocator(cost.NewAllocateRequest("2023-04", "app"))
+	// a:1 b:2 c:1, 权重为0时按1计算
+	a.Add(100, []*resource.Tag{
+		newTag("app", "a", 1, true),
+		newTag("app", "b", 2, true),
+		newTag("app", "c", 0, true),
+		newTag("app", "d", 5, false),
+		newTag("env", "prod", 1, true),
+	})
+	a.Add(30, []*resource.Tag{newTag("app", "b", 1, true)})
+	// 没有成本标签
+	a.Add(20, []*resource.Tag{newTag("app", "a", 1, false)})
+	a.AddUnmatched(5)
+
+	set := a.Result()
+	should.Equal(155.0, set.Total)
+	should.Equal(130.0, set.Allocated)
+	should.Equal(25.0, set.Unallocated)
+	should.Equal(int64(1), set.UnallocatedResourceCount)
+	if should.Len(set.Items, 3) {
+		should.Equal(&cost.Allocation{Value: "b", Amount: 80, ResourceCount: 2}, set.Items[0])
+		should.Equal(&cost.Allocation{Value: "a", Amount: 25, ResourceCount: 1}, set.Items[1])
+		should.Equal(&cost.Allocation{Value: "c", Amount: 25, ResourceCount: 1}, set.Items[2])
+	}
+}
+
+func TestAllocateRequestValidate(t *testing.T) {
+	should := assert.New(t)
+
+	should.NoError(cost.NewAllocateRequest("2023-04", "app").Validate())
+	should.Error(cost.NewAllocateRequest("2023-4-1", "app").Validate())
+	should.Error(cost.NewAllocateRequest("2023-04", "").Validate())
+}
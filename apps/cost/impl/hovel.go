@@ -0,0 +1,119 @@
+package impl
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/sqlbuilder"
+
+	"github.com/opengoats/cmdb/apps/cost"
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+func (s *service) allocate(ctx context.Context, req *cost.AllocateRequest, caller *resource.Caller) (*cost.AllocationSet, error) {
+	currencies, err := s.queryResourceCost(ctx, req, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	// 不同币种的费用不能相加, 需要调用方指定币种
+	if len(currencies) > 1 {
+		names := make([]string, 0, len(currencies))
+		for c := range currencies {
+			names = append(names, c)
+		}
+		sort.Strings(names)
+		return nil, exception.NewBadRequest("period %s has bills in currencies %s, currency required", req.Period, strings.Join(names, ","))
+	}
+
+	a := cost.NewAllocator(req)
+	for currency, costs := range currencies {
+		a.SetCurrency(currency)
+
+		// 没有关联到资源的账单直接计入未分摊
+		if amount, ok := costs[""]; ok {
+			a.AddUnmatched(amount)
+			delete(costs, "")
+		}
+		if len(costs) == 0 {
+			continue
+		}
+
+		ids := make([]string, 0, len(costs))
+		for id := range costs {
+			ids = append(ids, id)
+		}
+		tags, err := s.resource.QueryTag(ctx, &resource.QueryTagRequest{ResourceIds: ids, WithHidden: true})
+		if err != nil {
+			return nil, err
+		}
+
+		rts := tags.ResourceTags()
+		for id, amount := range costs {
+			a.Add(amount, rts[id])
+		}
+	}
+
+	return a.Result(), nil
+}
+
+// newCostQuery 账单周期内的费用查询条件
+// 调用方只能统计有权访问的资源的费用, 没有关联到资源的账单按账单的所属空间过滤
+func newCostQuery(req *cost.AllocateRequest, caller *resource.Caller) *sqlbuilder.Builder {
+	query := sqlbuilder.NewQuery(sqlQueryResourceCost).Where("b.month = ?", req.Period)
+	if req.Vendor != nil {
+		query.Where("b.vendor = ?", *req.Vendor)
+	}
+	if req.Currency != "" {
+		query.Where("b.currency = ?", req.Currency)
+	}
+	if caller != nil {
+		stmt, args := caller.BuildSQL()
+		if caller.Namespace != "" {
+			stmt, args = "("+stmt+" OR (r.id IS NULL AND b.namespace = ?))", append(args, caller.Namespace)
+		}
+		query.Where(stmt, args...)
+	}
+	return query.GroupBy("b.resource_id,b.currency")
+}
+
+// queryResourceCost 查询账单周期内每个资源的费用, 按币种分组
+func (s *service) queryResourceCost(ctx context.Context, req *cost.AllocateRequest, caller *resource.Caller) (map[string]map[string]float64, error) {
+	querySQL, args := newCostQuery(req, caller).Build()
+	s.log.Named("Allocate").Debugf("sql: %s; %v", querySQL, args)
+	stmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("Allocate").Error(err)
+		return nil, exception.NewInternalServerError("query resource cost err %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		s.log.Named("Allocate").Error(err)
+		return nil, exception.NewInternalServerError("query resource cost err %s", err)
+	}
+	defer rows.Close()
+
+	currencies := map[string]map[string]float64{}
+	for rows.Next() {
+		var id, currency string
+		var amount float64
+		if err := rows.Scan(&id, &currency, &amount); err != nil {
+			s.log.Named("Allocate").Error(err)
+			return nil, exception.NewInternalServerError("query resource cost err %s", err)
+		}
+		if _, ok := currencies[currency]; !ok {
+			currencies[currency] = map[string]float64{}
+		}
+		currencies[currency][id] = amount
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("Allocate").Error(err)
+		return nil, exception.NewInternalServerError("query resource cost err %s", err)
+	}
+
+	return currencies, nil
+}
@@ -0,0 +1,27 @@
+package impl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opengoats/cmdb/apps/cost"
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+func TestNewCostQuery(t *testing.T) {
+	should := assert.New(t)
+
+	req := cost.NewAllocateRequest("2023-04", "app")
+	req.Currency = "CNY"
+	querySQL, args := newCostQuery(req, resource.NewCaller("ns1")).Build()
+	should.Contains(querySQL, "b.currency = ?")
+	should.Contains(querySQL, "r.namespace = ?")
+	should.Contains(querySQL, "r.id IS NULL AND b.namespace = ?")
+	should.Contains(querySQL, "GROUP BY b.resource_id,b.currency")
+	should.Equal([]interface{}{"2023-04", "CNY", "ns1", resource.UsageMode_SHARED, "ns1"}, args)
+
+	// 服务内部调用不过滤资源
+	querySQL, _ = newCostQuery(req, nil).Build()
+	should.NotContains(querySQL, "r.namespace")
+}
@@ -0,0 +1,6 @@
+package impl
+
+const (
+	// 按资源和币种汇总账单周期内的费用, 关联资源用于过滤调用方有权访问的资源
+	sqlQueryResourceCost = `SELECT b.resource_id,b.currency,SUM(b.amount) FROM bill b LEFT JOIN resource r ON r.id = b.resource_id`
+)
@@ -0,0 +1,27 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/opengoats/goat/exception"
+
+	"github.com/opengoats/cmdb/apps/cost"
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+func (s *service) Allocate(ctx context.Context, req *cost.AllocateRequest) (*cost.AllocationSet, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("Allocate").Error(err)
+		return nil, exception.NewBadRequest("validate allocate cost error, %s", err)
+	}
+
+	// 调用方以认证后的身份为准, 只统计调用方有权访问的资源
+	caller, err := resource.NewCallerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按标签权重分摊
+	return s.allocate(ctx, req, caller)
+}
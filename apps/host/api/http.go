@@ -0,0 +1,72 @@
+package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/http/response"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service host.ServiceServer
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(host.AppName)
+	h.service = app.GetGrpcApp(host.AppName).(host.ServiceServer)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return host.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"hosts"}
+
+	ws.Route(ws.POST("").To(h.SaveHost).
+		Doc("create or update a host").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(host.Host{}).
+		Writes(response.NewMessage(resource.SaveResult{})))
+
+	ws.Route(ws.GET("/").To(h.QueryHost).
+		Doc("get all hosts").
+		Param(ws.QueryParameter("cpu_min", "minimum cpu cores").DataType("integer")).
+		Param(ws.QueryParameter("cpu_max", "maximum cpu cores").DataType("integer")).
+		Param(ws.QueryParameter("memory_min", "minimum memory in MB").DataType("integer")).
+		Param(ws.QueryParameter("memory_max", "maximum memory in MB").DataType("integer")).
+		Param(ws.QueryParameter("gpu_amount_min", "minimum gpu amount").DataType("integer")).
+		Param(ws.QueryParameter("os_type", "os type, e.g. linux").DataType("string")).
+		Param(ws.QueryParameter("os_name", "os name, fuzzy match").DataType("string")).
+		Param(ws.QueryParameter("image_id", "image id").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Metadata("action", "list").
+		Writes(response.NewMessage(host.HostSet{})).
+		Returns(200, "OK", host.HostSet{}))
+
+	ws.Route(ws.GET("/{id}").To(h.DescribeHost).
+		Doc("get a host").
+		Param(ws.PathParameter("id", "identifier of the host resource").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(response.NewMessage(host.Host{})).
+		Returns(200, "OK", response.NewMessage(host.Host{})).
+		Returns(404, "Not Found", nil))
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/response"
+)
+
+func (h *handler) SaveHost(r *restful.Request, w *restful.Response) {
+	req := host.NewDefaultHost()
+
+	if err := r.ReadEntity(req); err != nil {
+		h.log.Named("SaveHost").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read host error, %s", err))
+		return
+	}
+
+	result, err := h.service.SaveHost(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("SaveHost").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+	response.Success(w.ResponseWriter, result)
+}
+
+func (h *handler) QueryHost(r *restful.Request, w *restful.Response) {
+	// 加载查询条件
+	req, err := host.NewQueryHostRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("QueryHost").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse query host request error, %s", err))
+		return
+	}
+
+	// 数据查询
+	set, err := h.service.QueryHost(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("QueryHost").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, set)
+}
+
+func (h *handler) DescribeHost(r *restful.Request, w *restful.Response) {
+	req := host.NewDescribeHostRequest(r.PathParameter("id"))
+	ins, err := h.service.DescribeHost(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("DescribeHost").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, ins)
+}
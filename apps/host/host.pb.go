@@ -0,0 +1,706 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.22.0
+// source: apps/host/pb/host.proto
+
+package host
+
+import (
+	resource "github.com/opengoats/cmdb/apps/resource"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Host struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源通用信息
+	// @gotags: json:"resource" validate:"required"
+	Resource *resource.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource" validate:"required"`
+	// 主机特有信息
+	// @gotags: json:"describe" validate:"required"
+	Describe *Describe `protobuf:"bytes,2,opt,name=describe,proto3" json:"describe" validate:"required"`
+}
+
+func (x *Host) Reset() {
+	*x = Host{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_host_pb_host_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Host) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Host) ProtoMessage() {}
+
+func (x *Host) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_host_pb_host_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Host.ProtoReflect.Descriptor instead.
+func (*Host) Descriptor() ([]byte, []int) {
+	return file_apps_host_pb_host_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Host) GetResource() *resource.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *Host) GetDescribe() *Describe {
+	if x != nil {
+		return x.Describe
+	}
+	return nil
+}
+
+type Describe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 关联的资源Id
+	// @gotags: json:"resource_id"
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id"`
+	// cpu核数
+	// @gotags: json:"cpu"
+	Cpu int64 `protobuf:"varint,2,opt,name=cpu,proto3" json:"cpu"`
+	// 内存大小, 单位MB
+	// @gotags: json:"memory"
+	Memory int64 `protobuf:"varint,3,opt,name=memory,proto3" json:"memory"`
+	// gpu核数
+	// @gotags: json:"gpu_amount"
+	GpuAmount int64 `protobuf:"varint,4,opt,name=gpu_amount,json=gpuAmount,proto3" json:"gpu_amount"`
+	// gpu规格
+	// @gotags: json:"gpu_spec"
+	GpuSpec string `protobuf:"bytes,5,opt,name=gpu_spec,json=gpuSpec,proto3" json:"gpu_spec"`
+	// 操作系统类型, 比如 linux, windows
+	// @gotags: json:"os_type"
+	OsType string `protobuf:"bytes,6,opt,name=os_type,json=osType,proto3" json:"os_type"`
+	// 操作系统名称
+	// @gotags: json:"os_name"
+	OsName string `protobuf:"bytes,7,opt,name=os_name,json=osName,proto3" json:"os_name"`
+	// 系统序列号
+	// @gotags: json:"serial_number"
+	SerialNumber string `protobuf:"bytes,8,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number"`
+	// 镜像Id
+	// @gotags: json:"image_id"
+	ImageId string `protobuf:"bytes,9,opt,name=image_id,json=imageId,proto3" json:"image_id"`
+	// 外网最大出口带宽, 单位Mbps
+	// @gotags: json:"internet_max_bandwidth_out"
+	InternetMaxBandwidthOut int64 `protobuf:"varint,10,opt,name=internet_max_bandwidth_out,json=internetMaxBandwidthOut,proto3" json:"internet_max_bandwidth_out"`
+	// 外网最大入口带宽, 单位Mbps
+	// @gotags: json:"internet_max_bandwidth_in"
+	InternetMaxBandwidthIn int64 `protobuf:"varint,11,opt,name=internet_max_bandwidth_in,json=internetMaxBandwidthIn,proto3" json:"internet_max_bandwidth_in"`
+	// ssh key关联Id
+	// @gotags: json:"key_pair_name"
+	KeyPairName string `protobuf:"bytes,12,opt,name=key_pair_name,json=keyPairName,proto3" json:"key_pair_name"`
+	// 安全组Id列表
+	// @gotags: json:"security_groups"
+	SecurityGroups []string `protobuf:"bytes,13,rep,name=security_groups,json=securityGroups,proto3" json:"security_groups"`
+}
+
+func (x *Describe) Reset() {
+	*x = Describe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_host_pb_host_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Describe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Describe) ProtoMessage() {}
+
+func (x *Describe) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_host_pb_host_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Describe.ProtoReflect.Descriptor instead.
+func (*Describe) Descriptor() ([]byte, []int) {
+	return file_apps_host_pb_host_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Describe) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *Describe) GetCpu() int64 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *Describe) GetMemory() int64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *Describe) GetGpuAmount() int64 {
+	if x != nil {
+		return x.GpuAmount
+	}
+	return 0
+}
+
+func (x *Describe) GetGpuSpec() string {
+	if x != nil {
+		return x.GpuSpec
+	}
+	return ""
+}
+
+func (x *Describe) GetOsType() string {
+	if x != nil {
+		return x.OsType
+	}
+	return ""
+}
+
+func (x *Describe) GetOsName() string {
+	if x != nil {
+		return x.OsName
+	}
+	return ""
+}
+
+func (x *Describe) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *Describe) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *Describe) GetInternetMaxBandwidthOut() int64 {
+	if x != nil {
+		return x.InternetMaxBandwidthOut
+	}
+	return 0
+}
+
+func (x *Describe) GetInternetMaxBandwidthIn() int64 {
+	if x != nil {
+		return x.InternetMaxBandwidthIn
+	}
+	return 0
+}
+
+func (x *Describe) GetKeyPairName() string {
+	if x != nil {
+		return x.KeyPairName
+	}
+	return ""
+}
+
+func (x *Describe) GetSecurityGroups() []string {
+	if x != nil {
+		return x.SecurityGroups
+	}
+	return nil
+}
+
+type HostSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	// @gotags: json:"items"
+	Items []*Host `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+}
+
+func (x *HostSet) Reset() {
+	*x = HostSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_host_pb_host_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostSet) ProtoMessage() {}
+
+func (x *HostSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_host_pb_host_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostSet.ProtoReflect.Descriptor instead.
+func (*HostSet) Descriptor() ([]byte, []int) {
+	return file_apps_host_pb_host_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HostSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *HostSet) GetItems() []*Host {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type QueryHostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源通用的搜索条件
+	// @gotags: json:"search"
+	Search *resource.SearchRequest `protobuf:"bytes,1,opt,name=search,proto3" json:"search"`
+	// 最少cpu核数
+	// @gotags: json:"cpu_min"
+	CpuMin int64 `protobuf:"varint,2,opt,name=cpu_min,json=cpuMin,proto3" json:"cpu_min"`
+	// 最多cpu核数
+	// @gotags: json:"cpu_max"
+	CpuMax int64 `protobuf:"varint,3,opt,name=cpu_max,json=cpuMax,proto3" json:"cpu_max"`
+	// 最小内存, 单位MB
+	// @gotags: json:"memory_min"
+	MemoryMin int64 `protobuf:"varint,4,opt,name=memory_min,json=memoryMin,proto3" json:"memory_min"`
+	// 最大内存, 单位MB
+	// @gotags: json:"memory_max"
+	MemoryMax int64 `protobuf:"varint,5,opt,name=memory_max,json=memoryMax,proto3" json:"memory_max"`
+	// 最少gpu核数
+	// @gotags: json:"gpu_amount_min"
+	GpuAmountMin int64 `protobuf:"varint,6,opt,name=gpu_amount_min,json=gpuAmountMin,proto3" json:"gpu_amount_min"`
+	// 操作系统类型
+	// @gotags: json:"os_type"
+	OsType string `protobuf:"bytes,7,opt,name=os_type,json=osType,proto3" json:"os_type"`
+	// 操作系统名称, 支持模糊匹配
+	// @gotags: json:"os_name"
+	OsName string `protobuf:"bytes,8,opt,name=os_name,json=osName,proto3" json:"os_name"`
+	// 镜像Id
+	// @gotags: json:"image_id"
+	ImageId string `protobuf:"bytes,9,opt,name=image_id,json=imageId,proto3" json:"image_id"`
+}
+
+func (x *QueryHostRequest) Reset() {
+	*x = QueryHostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_host_pb_host_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryHostRequest) ProtoMessage() {}
+
+func (x *QueryHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_host_pb_host_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryHostRequest.ProtoReflect.Descriptor instead.
+func (*QueryHostRequest) Descriptor() ([]byte, []int) {
+	return file_apps_host_pb_host_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryHostRequest) GetSearch() *resource.SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *QueryHostRequest) GetCpuMin() int64 {
+	if x != nil {
+		return x.CpuMin
+	}
+	return 0
+}
+
+func (x *QueryHostRequest) GetCpuMax() int64 {
+	if x != nil {
+		return x.CpuMax
+	}
+	return 0
+}
+
+func (x *QueryHostRequest) GetMemoryMin() int64 {
+	if x != nil {
+		return x.MemoryMin
+	}
+	return 0
+}
+
+func (x *QueryHostRequest) GetMemoryMax() int64 {
+	if x != nil {
+		return x.MemoryMax
+	}
+	return 0
+}
+
+func (x *QueryHostRequest) GetGpuAmountMin() int64 {
+	if x != nil {
+		return x.GpuAmountMin
+	}
+	return 0
+}
+
+func (x *QueryHostRequest) GetOsType() string {
+	if x != nil {
+		return x.OsType
+	}
+	return ""
+}
+
+func (x *QueryHostRequest) GetOsName() string {
+	if x != nil {
+		return x.OsName
+	}
+	return ""
+}
+
+func (x *QueryHostRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type DescribeHostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源Id
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required"`
+}
+
+func (x *DescribeHostRequest) Reset() {
+	*x = DescribeHostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_host_pb_host_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeHostRequest) ProtoMessage() {}
+
+func (x *DescribeHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_host_pb_host_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeHostRequest.ProtoReflect.Descriptor instead.
+func (*DescribeHostRequest) Descriptor() ([]byte, []int) {
+	return file_apps_host_pb_host_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DescribeHostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_apps_host_pb_host_proto protoreflect.FileDescriptor
+
+var file_apps_host_pb_host_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x70, 0x62, 0x2f, 0x68,
+	0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6f, 0x70, 0x65, 0x6e, 0x67,
+	0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x1a, 0x1f,
+	0x61, 0x70, 0x70, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x70, 0x62,
+	0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x80, 0x01, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x2e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x22, 0xc6, 0x03, 0x0a, 0x08, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63,
+	0x70, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x70,
+	0x75, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x67, 0x70, 0x75, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x70, 0x75,
+	0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x70, 0x75,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x6f, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x4f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x69, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x4d, 0x61, 0x78, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x49, 0x6e, 0x12, 0x22,
+	0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x50, 0x0a, 0x07, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x68, 0x6f, 0x73,
+	0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xb5, 0x02,
+	0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63,
+	0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x70, 0x75, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x70, 0x75, 0x4d, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x70, 0x75, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x70,
+	0x75, 0x4d, 0x61, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d,
+	0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x61,
+	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x61, 0x78, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x70, 0x75, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x67, 0x70, 0x75, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x32, 0xfc, 0x01, 0x0a,
+	0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65,
+	0x48, 0x6f, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73,
+	0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x50, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x6f, 0x73,
+	0x74, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d,
+	0x64, 0x62, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67,
+	0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x65, 0x74, 0x12, 0x53, 0x0a, 0x0c, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61,
+	0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
+	0x62, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x25, 0x5a, 0x23, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f,
+	0x61, 0x74, 0x73, 0x2f, 0x63, 0x6d, 0x64, 0x62, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x68, 0x6f,
+	0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_host_pb_host_proto_rawDescOnce sync.Once
+	file_apps_host_pb_host_proto_rawDescData = file_apps_host_pb_host_proto_rawDesc
+)
+
+func file_apps_host_pb_host_proto_rawDescGZIP() []byte {
+	file_apps_host_pb_host_proto_rawDescOnce.Do(func() {
+		file_apps_host_pb_host_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_host_pb_host_proto_rawDescData)
+	})
+	return file_apps_host_pb_host_proto_rawDescData
+}
+
+var file_apps_host_pb_host_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_apps_host_pb_host_proto_goTypes = []interface{}{
+	(*Host)(nil),                   // 0: opengoats.cmdb.host.Host
+	(*Describe)(nil),               // 1: opengoats.cmdb.host.Describe
+	(*HostSet)(nil),                // 2: opengoats.cmdb.host.HostSet
+	(*QueryHostRequest)(nil),       // 3: opengoats.cmdb.host.QueryHostRequest
+	(*DescribeHostRequest)(nil),    // 4: opengoats.cmdb.host.DescribeHostRequest
+	(*resource.Resource)(nil),      // 5: opengoats.cmdb.resource.Resource
+	(*resource.SearchRequest)(nil), // 6: opengoats.cmdb.resource.SearchRequest
+	(*resource.SaveResult)(nil),    // 7: opengoats.cmdb.resource.SaveResult
+}
+var file_apps_host_pb_host_proto_depIdxs = []int32{
+	5, // 0: opengoats.cmdb.host.Host.resource:type_name -> opengoats.cmdb.resource.Resource
+	1, // 1: opengoats.cmdb.host.Host.describe:type_name -> opengoats.cmdb.host.Describe
+	0, // 2: opengoats.cmdb.host.HostSet.items:type_name -> opengoats.cmdb.host.Host
+	6, // 3: opengoats.cmdb.host.QueryHostRequest.search:type_name -> opengoats.cmdb.resource.SearchRequest
+	0, // 4: opengoats.cmdb.host.Service.SaveHost:input_type -> opengoats.cmdb.host.Host
+	3, // 5: opengoats.cmdb.host.Service.QueryHost:input_type -> opengoats.cmdb.host.QueryHostRequest
+	4, // 6: opengoats.cmdb.host.Service.DescribeHost:input_type -> opengoats.cmdb.host.DescribeHostRequest
+	7, // 7: opengoats.cmdb.host.Service.SaveHost:output_type -> opengoats.cmdb.resource.SaveResult
+	2, // 8: opengoats.cmdb.host.Service.QueryHost:output_type -> opengoats.cmdb.host.HostSet
+	0, // 9: opengoats.cmdb.host.Service.DescribeHost:output_type -> opengoats.cmdb.host.Host
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_apps_host_pb_host_proto_init() }
+func file_apps_host_pb_host_proto_init() {
+	if File_apps_host_pb_host_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_host_pb_host_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Host); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_host_pb_host_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Describe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_host_pb_host_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_host_pb_host_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryHostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_host_pb_host_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeHostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_host_pb_host_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_apps_host_pb_host_proto_goTypes,
+		DependencyIndexes: file_apps_host_pb_host_proto_depIdxs,
+		MessageInfos:      file_apps_host_pb_host_proto_msgTypes,
+	}.Build()
+	File_apps_host_pb_host_proto = out.File
+	file_apps_host_pb_host_proto_rawDesc = nil
+	file_apps_host_pb_host_proto_goTypes = nil
+	file_apps_host_pb_host_proto_depIdxs = nil
+}
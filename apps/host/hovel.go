@@ -0,0 +1,157 @@
+package host
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator"
+
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+var (
+	validate = validator.New()
+)
+
+func (h *Host) Validate() error {
+	if err := validate.Struct(h); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func (r *QueryHostRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+	if r.Search == nil {
+		r.Search = resource.NewSearchRequest()
+	}
+	return r.Search.Validate()
+}
+
+func (r *DescribeHostRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewDefaultHost() *Host {
+	return &Host{
+		Resource: resource.NewDefaultResource(),
+		Describe: NewDefaultDescribe(),
+	}
+}
+
+func NewDefaultDescribe() *Describe {
+	return &Describe{
+		SecurityGroups: []string{},
+	}
+}
+
+// DescribeMap 主机特有属性, 用于计算describe_hash
+func (d *Describe) DescribeMap() map[string]string {
+	return map[string]string{
+		"cpu":                        strconv.FormatInt(d.Cpu, 10),
+		"memory":                     strconv.FormatInt(d.Memory, 10),
+		"gpu_amount":                 strconv.FormatInt(d.GpuAmount, 10),
+		"gpu_spec":                   d.GpuSpec,
+		"os_type":                    d.OsType,
+		"os_name":                    d.OsName,
+		"serial_number":              d.SerialNumber,
+		"image_id":                   d.ImageId,
+		"internet_max_bandwidth_out": strconv.FormatInt(d.InternetMaxBandwidthOut, 10),
+		"internet_max_bandwidth_in":  strconv.FormatInt(d.InternetMaxBandwidthIn, 10),
+		"key_pair_name":              d.KeyPairName,
+		"security_groups":            d.SecurityGroupsToString(),
+	}
+}
+
+// SecurityGroupsToString 数据库中多个安全组以逗号分隔存储
+func (d *Describe) SecurityGroupsToString() string {
+	return strings.Join(d.SecurityGroups, ",")
+}
+
+func (d *Describe) LoadSecurityGroupsString(s string) {
+	if s != "" {
+		d.SecurityGroups = strings.Split(s, ",")
+	}
+}
+
+func NewHostSet() *HostSet {
+	return &HostSet{
+		Items: []*Host{},
+	}
+}
+
+func (s *HostSet) Add(item *Host) {
+	s.Items = append(s.Items, item)
+}
+
+// ResourceIds 当前页所有主机的资源Id
+func (s *HostSet) ResourceIds() (ids []string) {
+	for i := range s.Items {
+		ids = append(ids, s.Items[i].Resource.Id)
+	}
+	return
+}
+
+// UpdateTag 按资源Id把标签回填到对应主机上
+func (s *HostSet) UpdateTag(tags *resource.TagSet) {
+	rts := tags.ResourceTags()
+	for i := range s.Items {
+		s.Items[i].Resource.Tags = append(s.Items[i].Resource.Tags, rts[s.Items[i].Resource.Id]...)
+	}
+}
+
+func NewQueryHostRequest() *QueryHostRequest {
+	return &QueryHostRequest{
+		Search: resource.NewSearchRequest(),
+	}
+}
+
+// NewQueryHostRequestFromHTTP 在资源通用搜索条件的基础上, 加载主机的过滤条件
+func NewQueryHostRequestFromHTTP(r *http.Request) (*QueryHostRequest, error) {
+	search, err := resource.NewSearchRequestFromHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
+	qs := r.URL.Query()
+	req := &QueryHostRequest{
+		Search:  search,
+		OsType:  qs.Get("os_type"),
+		OsName:  qs.Get("os_name"),
+		ImageId: qs.Get("image_id"),
+	}
+
+	ints := map[string]*int64{
+		"cpu_min":        &req.CpuMin,
+		"cpu_max":        &req.CpuMax,
+		"memory_min":     &req.MemoryMin,
+		"memory_max":     &req.MemoryMax,
+		"gpu_amount_min": &req.GpuAmountMin,
+	}
+	for k, v := range ints {
+		if qs.Get(k) == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(qs.Get(k), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		*v = n
+	}
+
+	return req, nil
+}
+
+func NewDescribeHostRequest(id string) *DescribeHostRequest {
+	return &DescribeHostRequest{
+		Id: id,
+	}
+}
@@ -0,0 +1,204 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/sqlbuilder"
+)
+
+func (s *service) saveHost(ctx context.Context, ins *host.Host) (*resource.SaveResult, error) {
+	// 通用属性交给资源服务保存, 特有属性参与describe_hash计算
+	ins.Resource.ResourceType = resource.Type_HOST
+	req := resource.NewSaveRequest(ins.Resource)
+	req.Describe = ins.Describe.DescribeMap()
+
+	// 主机特有属性与资源在同一个事务中写入, 写入失败时describe_hash一起回滚, 下次同步时重新写入
+	return s.resource.SaveWithDescribe(ctx, req, func(ctx context.Context, tx *sql.Tx, resourceId string) error {
+		ins.Describe.ResourceId = resourceId
+		return s.saveDescribe(ctx, tx, ins.Describe)
+	})
+}
+
+// saveDescribe 主机特有属性没有变化时资源服务不会调用
+func (s *service) saveDescribe(ctx context.Context, tx *sql.Tx, d *host.Describe) error {
+	s.log.Named("SaveHost").Debugf("sql: %s", sqlInsertOrUpdateHost)
+	_, err := tx.ExecContext(ctx, sqlInsertOrUpdateHost,
+		d.ResourceId, d.Cpu, d.Memory, d.GpuAmount, d.GpuSpec, d.OsType, d.OsName, d.SerialNumber, d.ImageId,
+		d.InternetMaxBandwidthOut, d.InternetMaxBandwidthIn, d.KeyPairName, d.SecurityGroupsToString(),
+	)
+	if err != nil {
+		s.log.Named("SaveHost").Error(err)
+		return exception.NewInternalServerError("save host err %s", err)
+	}
+	return nil
+}
+
+// newHostQuery 在资源通用搜索条件的基础上增加主机的过滤条件
+func newHostQuery(req *host.QueryHostRequest) (*sqlbuilder.Builder, string, error) {
+	query, join, err := resource.NewSearchQuery(sqlQueryHost, req.Search)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if req.CpuMin > 0 {
+		query.Where("h.cpu >= ?", req.CpuMin)
+	}
+	if req.CpuMax > 0 {
+		query.Where("h.cpu <= ?", req.CpuMax)
+	}
+	if req.MemoryMin > 0 {
+		query.Where("h.memory >= ?", req.MemoryMin)
+	}
+	if req.MemoryMax > 0 {
+		query.Where("h.memory <= ?", req.MemoryMax)
+	}
+	if req.GpuAmountMin > 0 {
+		query.Where("h.gpu_amount >= ?", req.GpuAmountMin)
+	}
+	if req.OsType != "" {
+		query.Where("h.os_type = ?", req.OsType)
+	}
+	if req.OsName != "" {
+		query.Where("h.os_name LIKE ?", "%"+req.OsName+"%")
+	}
+	if req.ImageId != "" {
+		query.Where("h.image_id = ?", req.ImageId)
+	}
+
+	return query, join, nil
+}
+
+func (s *service) queryHost(ctx context.Context, req *host.QueryHostRequest) (*host.HostSet, error) {
+	query, join, err := newHostQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	set := host.NewHostSet()
+
+	// 获取total, 需要在GroupBy之前构建, 否则COUNT会按分组统计
+	countSQL, args := query.BuildFromNewBase(fmt.Sprintf(sqlCountHost, join))
+	s.log.Named("QueryHost").Debugf("sql: %s; %v", countSQL, args)
+	countStmt, err := s.db.PrepareContext(ctx, countSQL)
+	if err != nil {
+		s.log.Named("QueryHost").Error(err)
+		return nil, exception.NewInternalServerError("count host err %s", err)
+	}
+	defer countStmt.Close()
+
+	err = countStmt.QueryRowContext(ctx, args...).Scan(&set.Total)
+	if err != nil {
+		s.log.Named("QueryHost").Error(err)
+		return nil, exception.NewInternalServerError("count host err %s", err)
+	}
+
+	// 获取分页数据
+	page := req.Search.Page
+	querySQL, args := query.GroupBy("r.id").Order("r.sync_at").Desc().
+		Limit(page.ComputeOffset(), uint(page.PageSize)).Build()
+	s.log.Named("QueryHost").Debugf("sql: %s; %v", querySQL, args)
+	queryStmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("QueryHost").Error(err)
+		return nil, exception.NewInternalServerError("query host err %s", err)
+	}
+	defer queryStmt.Close()
+
+	rows, err := queryStmt.QueryContext(ctx, args...)
+	if err != nil {
+		s.log.Named("QueryHost").Error(err)
+		return nil, exception.NewInternalServerError("query host err %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ins, err := scanHost(rows)
+		if err != nil {
+			s.log.Named("QueryHost").Error(err)
+			return nil, exception.NewInternalServerError("query host err %s", err)
+		}
+		set.Add(ins)
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("QueryHost").Error(err)
+		return nil, exception.NewInternalServerError("query host err %s", err)
+	}
+
+	// 按需补充主机标签
+	if req.Search.WithTags && len(set.Items) > 0 {
+		tags, err := s.resource.QueryTag(ctx, resource.NewQueryTagRequest(set.ResourceIds()...))
+		if err != nil {
+			return nil, err
+		}
+		set.UpdateTag(tags)
+	}
+
+	return set, nil
+}
+
+func (s *service) describeHost(ctx context.Context, req *host.DescribeHostRequest) (*host.Host, error) {
+	query := sqlbuilder.NewQuery(sqlQueryHost, "LEFT").Where("r.id = ?", req.Id).GroupBy("r.id")
+
+	querySQL, args := query.Build()
+	s.log.Named("DescribeHost").Debugf("sql: %s; %v", querySQL, args)
+	stmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("DescribeHost").Error(err)
+		return nil, exception.NewInternalServerError("describe host err %s", err)
+	}
+	defer stmt.Close()
+
+	ins, err := scanHost(stmt.QueryRowContext(ctx, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, exception.NewNotFound("host %s not found", req.Id)
+		}
+		s.log.Named("DescribeHost").Error(err)
+		return nil, exception.NewInternalServerError("describe host err %s", err)
+	}
+
+	tags, err := s.resource.QueryTag(ctx, resource.NewQueryTagRequest(ins.Resource.Id))
+	if err != nil {
+		return nil, err
+	}
+	ins.Resource.Tags = tags.Items
+
+	return ins, nil
+}
+
+// 字段顺序与sqlQueryHost保持一致
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanHost(row scanner) (*host.Host, error) {
+	ins := host.NewDefaultHost()
+	r, d := ins.Resource, ins.Describe
+	var publicIP, privateIP, sharedTagValues, securityGroups string
+	err := row.Scan(
+		&r.Id, &r.Status, &r.CreateAt, &r.CreateBy, &r.UpdateAt, &r.UpdateBy, &r.DeleteAt, &r.DeleteBy,
+		&r.Cid, &r.ResourceType, &r.Vendor, &r.Region, &r.Zone, &r.ExpireAt, &r.Category, &r.Type,
+		&r.Name, &r.Description, &r.CStatus, &r.SyncAt, &r.SyncAccount,
+		&publicIP, &privateIP, &r.PayType, &r.DescribeHash,
+		&r.ResourceHash, &r.SecretId, &r.Domain, &r.Namespace, &r.Env, &r.UsageMode,
+		&r.SharedPolicy.TagKey, &sharedTagValues,
+		&d.Cpu, &d.Memory, &d.GpuAmount, &d.GpuSpec, &d.OsType,
+		&d.OsName, &d.SerialNumber, &d.ImageId,
+		&d.InternetMaxBandwidthOut, &d.InternetMaxBandwidthIn,
+		&d.KeyPairName, &securityGroups,
+	)
+	if err != nil {
+		return nil, err
+	}
+	r.LoadPublicIPString(publicIP)
+	r.LoadPrivateIPString(privateIP)
+	r.SharedPolicy.LoadTagValuesString(sharedTagValues)
+	d.ResourceId = r.Id
+	d.LoadSecurityGroupsString(securityGroups)
+	return ins, nil
+}
@@ -0,0 +1,50 @@
+package impl
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+func TestNewHostQuery(t *testing.T) {
+	should := assert.New(t)
+
+	r := httptest.NewRequest("GET", "/host/api/v1/host?cpu_min=8&memory_max=16384&os_type=linux&env=prod", nil)
+	req, err := host.NewQueryHostRequestFromHTTP(r)
+	if !should.NoError(err) {
+		return
+	}
+	should.Equal(int64(8), req.CpuMin)
+	should.Equal(int64(16384), req.MemoryMax)
+
+	query, join, err := newHostQuery(req)
+	if should.NoError(err) {
+		should.Equal("LEFT", join)
+		querySQL, args := query.Build()
+		should.Contains(querySQL, "INNER JOIN resource_host h ON h.resource_id = r.id LEFT JOIN resource_tag t")
+		should.Contains(querySQL, "h.cpu >= ?")
+		should.Contains(querySQL, "h.memory <= ?")
+		should.Contains(querySQL, "h.os_type = ?")
+		should.Equal([]interface{}{"prod", int64(8), int64(16384), "linux"}, args)
+	}
+
+	_, err = host.NewQueryHostRequestFromHTTP(httptest.NewRequest("GET", "/?cpu_min=x", nil))
+	should.Error(err)
+}
+
+func TestNewHostQueryWithCaller(t *testing.T) {
+	should := assert.New(t)
+
+	req := host.NewQueryHostRequest()
+	req.Search.Caller = resource.NewCaller("ns1")
+	query, _, err := newHostQuery(req)
+	if should.NoError(err) {
+		querySQL, args := query.Build()
+		should.Contains(querySQL, "r.namespace = ?")
+		should.Contains(args, "ns1")
+	}
+}
@@ -0,0 +1,51 @@
+package impl
+
+import (
+	"database/sql"
+
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+	"google.golang.org/grpc"
+
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/conf"
+)
+
+var (
+	// Service 服务实例
+	svr = &service{}
+)
+
+type service struct {
+	db       *sql.DB
+	log      logger.Logger
+	resource resource.Service
+	host.UnimplementedServiceServer
+}
+
+func (s *service) Config() error {
+	db, err := conf.C().MySQL.GetDB()
+	if err != nil {
+		return err
+	}
+	s.db = db
+
+	s.log = zap.L().Named(s.Name())
+	// 主机的通用属性和标签由资源服务维护
+	s.resource = app.GetGrpcApp(resource.AppName).(resource.Service)
+	return nil
+}
+
+func (s *service) Name() string {
+	return host.AppName
+}
+
+func (s *service) Registry(server *grpc.Server) {
+	host.RegisterServiceServer(server, svr)
+}
+
+func init() {
+	app.RegistryGrpcApp(svr)
+}
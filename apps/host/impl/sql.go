@@ -0,0 +1,30 @@
+package impl
+
+const (
+	sqlInsertOrUpdateHost = `INSERT INTO resource_host (
+		resource_id,cpu,memory,gpu_amount,gpu_spec,os_type,os_name,serial_number,image_id,
+		internet_max_bandwidth_out,internet_max_bandwidth_in,key_pair_name,security_groups
+	) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)
+	ON DUPLICATE KEY UPDATE
+		cpu=VALUES(cpu),memory=VALUES(memory),gpu_amount=VALUES(gpu_amount),gpu_spec=VALUES(gpu_spec),
+		os_type=VALUES(os_type),os_name=VALUES(os_name),serial_number=VALUES(serial_number),
+		image_id=VALUES(image_id),internet_max_bandwidth_out=VALUES(internet_max_bandwidth_out),
+		internet_max_bandwidth_in=VALUES(internet_max_bandwidth_in),key_pair_name=VALUES(key_pair_name),
+		security_groups=VALUES(security_groups);`
+
+	// 主机信息 = 资源通用信息 + 主机特有信息, 保留标签JOIN方式的占位符, 用于标签过滤
+	sqlQueryHost = `SELECT 
+		r.id,r.status,r.create_at,r.create_by,r.update_at,r.update_by,r.delete_at,r.delete_by,
+		r.c_id,r.resource_type,r.vendor,r.region,r.zone,IFNULL(r.expire_at,0),r.category,r.type,
+		r.name,IFNULL(r.description,''),r.c_status,IFNULL(r.sync_at,0),IFNULL(r.sync_accout,''),
+		IFNULL(r.public_ip,''),IFNULL(r.private_ip,''),IFNULL(r.pay_type,''),r.describe_hash,
+		r.resource_hash,r.secret_id,r.domain,r.namespace,r.env,r.usage_mode,
+		r.shared_tag_key,r.shared_tag_values,
+		h.cpu,h.memory,IFNULL(h.gpu_amount,0),IFNULL(h.gpu_spec,''),IFNULL(h.os_type,''),
+		IFNULL(h.os_name,''),IFNULL(h.serial_number,''),IFNULL(h.image_id,''),
+		IFNULL(h.internet_max_bandwidth_out,0),IFNULL(h.internet_max_bandwidth_in,0),
+		IFNULL(h.key_pair_name,''),IFNULL(h.security_groups,'') 
+	FROM resource r INNER JOIN resource_host h ON h.resource_id = r.id %s JOIN resource_tag t ON r.id = t.resource_id`
+	// 使用DISTINCT对字段去重, 用于分页时使用
+	sqlCountHost = `SELECT COUNT(DISTINCT r.id) FROM resource r INNER JOIN resource_host h ON h.resource_id = r.id %s JOIN resource_tag t ON r.id = t.resource_id`
+)
@@ -0,0 +1,76 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/request"
+)
+
+func (s *service) SaveHost(ctx context.Context, req *host.Host) (*resource.SaveResult, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("SaveHost").Error(err)
+		return nil, exception.NewBadRequest("validate save host error, %s", err)
+	}
+
+	// 数据库保存
+	return s.saveHost(ctx, req)
+}
+
+func (s *service) QueryHost(ctx context.Context, req *host.QueryHostRequest) (*host.HostSet, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("QueryHost").Error(err)
+		return nil, exception.NewBadRequest("validate query host error, %s", err)
+	}
+
+	// 调用方以认证后的身份为准, 忽略请求中传递的值
+	caller, err := resource.NewCallerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Search.Caller = caller
+
+	// 分页默认值填充
+	if req.Search.Page == nil {
+		req.Search.Page = request.NewDefaultPageRequest()
+	}
+	if req.Search.Page.PageSize == 0 {
+		req.Search.Page.PageSize = request.DefaultPageSize
+	}
+	if req.Search.Page.PageNumber == 0 {
+		req.Search.Page.PageNumber = request.DefaultPageNumber
+	}
+
+	// 数据库查询
+	return s.queryHost(ctx, req)
+}
+
+func (s *service) DescribeHost(ctx context.Context, req *host.DescribeHostRequest) (*host.Host, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("DescribeHost").Error(err)
+		return nil, exception.NewBadRequest("validate describe host error, %s", err)
+	}
+
+	// 调用方以认证后的身份为准
+	caller, err := resource.NewCallerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 数据库查询
+	ins, err := s.describeHost(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只允许查询调用方有权访问的主机
+	if !ins.Resource.IsVisibleTo(caller) {
+		return nil, exception.NewPermissionDeny("host %s is not shared to caller", req.Id)
+	}
+	return ins, nil
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/idc"
+	"github.com/opengoats/cmdb/common/auth"
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/http/response"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+)
+
+var (
+	h = &handler{}
+)
+
+const (
+	// 导入文件最大32M
+	maxImportFileSize = 32 << 20
+)
+
+type handler struct {
+	service idc.ServiceServer
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(idc.AppName)
+	h.service = app.GetGrpcApp(idc.AppName).(idc.ServiceServer)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return idc.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"idc"}
+
+	ws.Route(ws.POST("/import").To(h.ImportResources).
+		Doc("import IDC resources, hosts and tags from csv or json file, upsert by c_id").
+		Consumes("text/csv", "application/json", "application/octet-stream").
+		Param(ws.QueryParameter("format", "import file format").DataType("string").PossibleValues([]string{"CSV", "JSON"}).DefaultValue("CSV")).
+		Param(ws.QueryParameter("dry_run", "only validate and compare, write nothing").DataType("boolean")).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, caller identity, user or app tag is recorded as create_by").DataType("string")).
+		Param(ws.BodyParameter("file", "csv or json file").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(response.NewMessage(idc.ImportReport{})).
+		Returns(200, "OK", idc.ImportReport{}).
+		Returns(400, "Bad Request", nil))
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/idc"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/response"
+)
+
+func (h *handler) ImportResources(r *restful.Request, w *restful.Response) {
+	// 读取导入文件
+	data, err := io.ReadAll(http.MaxBytesReader(w.ResponseWriter, r.Request.Body, maxImportFileSize))
+	if err != nil {
+		h.log.Named("ImportResources").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read import file error, %s", err))
+		return
+	}
+
+	req, err := idc.NewImportRequestFromHTTP(r.Request, data)
+	if err != nil {
+		h.log.Named("ImportResources").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse import request error, %s", err))
+		return
+	}
+
+	report, err := h.service.ImportResources(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("ImportResources").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, report)
+}
@@ -0,0 +1,5 @@
+package idc
+
+const (
+	AppName = "idc"
+)
@@ -0,0 +1,89 @@
+package idc
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator"
+
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+var (
+	validate = validator.New()
+)
+
+func (r *ImportRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewImportRequest(format ImportFormat, data []byte) *ImportRequest {
+	return &ImportRequest{
+		Format: format,
+		Data:   data,
+	}
+}
+
+// NewImportRequestFromHTTP 导入文件为请求体, 其他参数从Query参数中加载
+func NewImportRequestFromHTTP(r *http.Request, data []byte) (*ImportRequest, error) {
+	qs := r.URL.Query()
+
+	req := NewImportRequest(ImportFormat_CSV, data)
+	if f := qs.Get("format"); f != "" {
+		format, err := ParseImportFormatFromString(f)
+		if err != nil {
+			return nil, err
+		}
+		req.Format = format
+	}
+	req.DryRun = qs.Get("dry_run") == "true"
+	return req, nil
+}
+
+func NewImportReport(dryRun bool) *ImportReport {
+	return &ImportReport{
+		DryRun: dryRun,
+		Items:  []*ImportRow{},
+	}
+}
+
+// Add 添加一行的导入结果, 并按结果计数
+func (r *ImportReport) Add(item *ImportRow) {
+	r.Items = append(r.Items, item)
+	r.Total++
+
+	switch item.Status {
+	case resource.SaveStatus_CREATED:
+		r.Created++
+	case resource.SaveStatus_UPDATED:
+		r.Updated++
+	case resource.SaveStatus_UNCHANGED:
+		r.Unchanged++
+	case resource.SaveStatus_FAILED:
+		r.Failed++
+	}
+}
+
+func NewImportRow(row *Row) *ImportRow {
+	return &ImportRow{
+		Line:         row.Line,
+		Cid:          row.Host.Resource.Cid,
+		ResourceType: row.Host.Resource.ResourceType,
+	}
+}
+
+func (r *ImportRow) Failed(format string, a ...interface{}) {
+	r.Status = resource.SaveStatus_FAILED
+	r.Message = fmt.Sprintf(format, a...)
+}
+
+// Update 使用资源的保存结果更新导入结果
+func (r *ImportRow) Update(result *resource.SaveResult) {
+	r.Id = result.Id
+	r.Status = result.Status
+	r.Message = result.Message
+}
@@ -0,0 +1,518 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.22.0
+// source: apps/idc/pb/idc.proto
+
+package idc
+
+import (
+	resource "github.com/opengoats/cmdb/apps/resource"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 导入文件的格式
+type ImportFormat int32
+
+const (
+	// 第一行为列名, 每行一个资源
+	ImportFormat_CSV ImportFormat = 0
+	// 数组, 每个元素与主机的结构相同: {"resource": {...}, "describe": {...}}
+	ImportFormat_JSON ImportFormat = 1
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "CSV",
+		1: "JSON",
+	}
+	ImportFormat_value = map[string]int32{
+		"CSV":  0,
+		"JSON": 1,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_idc_pb_idc_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_apps_idc_pb_idc_proto_enumTypes[0]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_apps_idc_pb_idc_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 文件格式
+	// @gotags: json:"format"
+	Format ImportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=opengoats.cmdb.idc.ImportFormat" json:"format"`
+	// 导入的文件内容
+	// @gotags: json:"data" validate:"required"
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data" validate:"required"`
+	// 只校验和比对, 不写入数据库
+	// @gotags: json:"dry_run"
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	// 导入人, 外部请求以认证后的调用方为准
+	// @gotags: json:"create_by"
+	CreateBy string `protobuf:"bytes,4,opt,name=create_by,json=createBy,proto3" json:"create_by"`
+}
+
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_idc_pb_idc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRequest) ProtoMessage() {}
+
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_idc_pb_idc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_apps_idc_pb_idc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImportRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_CSV
+}
+
+func (x *ImportRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportRequest) GetCreateBy() string {
+	if x != nil {
+		return x.CreateBy
+	}
+	return ""
+}
+
+// 每一行的导入结果
+type ImportRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 行号, CSV为文件中的行号(包含列名), JSON为数组下标+1
+	// @gotags: json:"line"
+	Line int64 `protobuf:"varint,1,opt,name=line,proto3" json:"line"`
+	// 资源的c_id
+	// @gotags: json:"c_id"
+	Cid string `protobuf:"bytes,2,opt,name=cid,proto3" json:"c_id"`
+	// 资源类型
+	// @gotags: json:"resource_type"
+	ResourceType resource.Type `protobuf:"varint,3,opt,name=resource_type,json=resourceType,proto3,enum=opengoats.cmdb.resource.Type" json:"resource_type"`
+	// 资源id, 新建资源在dry run时为空
+	// @gotags: json:"id"
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id"`
+	// 导入结果
+	// @gotags: json:"status"
+	Status resource.SaveStatus `protobuf:"varint,5,opt,name=status,proto3,enum=opengoats.cmdb.resource.SaveStatus" json:"status"`
+	// 失败原因
+	// @gotags: json:"message,omitempty"
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ImportRow) Reset() {
+	*x = ImportRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_idc_pb_idc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRow) ProtoMessage() {}
+
+func (x *ImportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_idc_pb_idc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRow.ProtoReflect.Descriptor instead.
+func (*ImportRow) Descriptor() ([]byte, []int) {
+	return file_apps_idc_pb_idc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportRow) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRow) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *ImportRow) GetResourceType() resource.Type {
+	if x != nil {
+		return x.ResourceType
+	}
+	return resource.Type(0)
+}
+
+func (x *ImportRow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportRow) GetStatus() resource.SaveStatus {
+	if x != nil {
+		return x.Status
+	}
+	return resource.SaveStatus(0)
+}
+
+func (x *ImportRow) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 是否是dry run
+	// @gotags: json:"dry_run"
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	// 总行数
+	// @gotags: json:"total"
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+	// 新建的资源数量
+	// @gotags: json:"created"
+	Created int64 `protobuf:"varint,3,opt,name=created,proto3" json:"created"`
+	// 更新的资源数量
+	// @gotags: json:"updated"
+	Updated int64 `protobuf:"varint,4,opt,name=updated,proto3" json:"updated"`
+	// 没有变化的资源数量
+	// @gotags: json:"unchanged"
+	Unchanged int64 `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged"`
+	// 失败的行数
+	// @gotags: json:"failed"
+	Failed int64 `protobuf:"varint,6,opt,name=failed,proto3" json:"failed"`
+	// 每一行的导入结果
+	// @gotags: json:"items"
+	Items []*ImportRow `protobuf:"bytes,7,rep,name=items,proto3" json:"items"`
+}
+
+func (x *ImportReport) Reset() {
+	*x = ImportReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_idc_pb_idc_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReport) ProtoMessage() {}
+
+func (x *ImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_idc_pb_idc_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReport.ProtoReflect.Descriptor instead.
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return file_apps_idc_pb_idc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportReport) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportReport) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportReport) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportReport) GetUnchanged() int64 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ImportReport) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportReport) GetItems() []*ImportRow {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_apps_idc_pb_idc_proto protoreflect.FileDescriptor
+
+var file_apps_idc_pb_idc_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x69, 0x64, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x69, 0x64,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61,
+	0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x69, 0x64, 0x63, 0x1a, 0x1f, 0x61, 0x70, 0x70,
+	0x73, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x01, 0x0a,
+	0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e,
+	0x69, 0x64, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x79, 0x22, 0xdc, 0x01, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0xdc, 0x01, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x69, 0x64, 0x63, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x2a, 0x21, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f,
+	0x4e, 0x10, 0x01, 0x32, 0x61, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56,
+	0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d,
+	0x64, 0x62, 0x2e, 0x69, 0x64, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73,
+	0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x69, 0x64, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2f, 0x63,
+	0x6d, 0x64, 0x62, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x69, 0x64, 0x63, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_idc_pb_idc_proto_rawDescOnce sync.Once
+	file_apps_idc_pb_idc_proto_rawDescData = file_apps_idc_pb_idc_proto_rawDesc
+)
+
+func file_apps_idc_pb_idc_proto_rawDescGZIP() []byte {
+	file_apps_idc_pb_idc_proto_rawDescOnce.Do(func() {
+		file_apps_idc_pb_idc_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_idc_pb_idc_proto_rawDescData)
+	})
+	return file_apps_idc_pb_idc_proto_rawDescData
+}
+
+var file_apps_idc_pb_idc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_apps_idc_pb_idc_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_apps_idc_pb_idc_proto_goTypes = []interface{}{
+	(ImportFormat)(0),        // 0: opengoats.cmdb.idc.ImportFormat
+	(*ImportRequest)(nil),    // 1: opengoats.cmdb.idc.ImportRequest
+	(*ImportRow)(nil),        // 2: opengoats.cmdb.idc.ImportRow
+	(*ImportReport)(nil),     // 3: opengoats.cmdb.idc.ImportReport
+	(resource.Type)(0),       // 4: opengoats.cmdb.resource.Type
+	(resource.SaveStatus)(0), // 5: opengoats.cmdb.resource.SaveStatus
+}
+var file_apps_idc_pb_idc_proto_depIdxs = []int32{
+	0, // 0: opengoats.cmdb.idc.ImportRequest.format:type_name -> opengoats.cmdb.idc.ImportFormat
+	4, // 1: opengoats.cmdb.idc.ImportRow.resource_type:type_name -> opengoats.cmdb.resource.Type
+	5, // 2: opengoats.cmdb.idc.ImportRow.status:type_name -> opengoats.cmdb.resource.SaveStatus
+	2, // 3: opengoats.cmdb.idc.ImportReport.items:type_name -> opengoats.cmdb.idc.ImportRow
+	1, // 4: opengoats.cmdb.idc.Service.ImportResources:input_type -> opengoats.cmdb.idc.ImportRequest
+	3, // 5: opengoats.cmdb.idc.Service.ImportResources:output_type -> opengoats.cmdb.idc.ImportReport
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_apps_idc_pb_idc_proto_init() }
+func file_apps_idc_pb_idc_proto_init() {
+	if File_apps_idc_pb_idc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_idc_pb_idc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_idc_pb_idc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_idc_pb_idc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_idc_pb_idc_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_apps_idc_pb_idc_proto_goTypes,
+		DependencyIndexes: file_apps_idc_pb_idc_proto_depIdxs,
+		EnumInfos:         file_apps_idc_pb_idc_proto_enumTypes,
+		MessageInfos:      file_apps_idc_pb_idc_proto_msgTypes,
+	}.Build()
+	File_apps_idc_pb_idc_proto = out.File
+	file_apps_idc_pb_idc_proto_rawDesc = nil
+	file_apps_idc_pb_idc_proto_goTypes = nil
+	file_apps_idc_pb_idc_proto_depIdxs = nil
+}
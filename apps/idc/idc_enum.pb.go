@@ -0,0 +1,55 @@
+// Code generated by github.com/opengoats/goat
+// DO NOT EDIT
+
+package idc
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseImportFormatFromString Parse ImportFormat from string
+func ParseImportFormatFromString(str string) (ImportFormat, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := ImportFormat_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown ImportFormat: %s", str)
+	}
+
+	return ImportFormat(v), nil
+}
+
+// Equal type compare
+func (t ImportFormat) Equal(target ImportFormat) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t ImportFormat) IsIn(targets ...ImportFormat) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t ImportFormat) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *ImportFormat) UnmarshalJSON(b []byte) error {
+	ins, err := ParseImportFormatFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
@@ -0,0 +1,69 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/opengoats/cmdb/apps/idc"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/exception"
+)
+
+func (s *service) importResources(ctx context.Context, req *idc.ImportRequest) (*idc.ImportReport, error) {
+	rows, err := idc.Parse(req.Format, bytes.NewReader(req.Data))
+	if err != nil {
+		s.log.Named("ImportResources").Error(err)
+		return nil, exception.NewBadRequest("parse import file error, %s", err)
+	}
+
+	// 逐行校验和保存, 单行失败不影响其他行
+	report := idc.NewImportReport(req.DryRun)
+	lines := map[string]int64{}
+	for i := range rows {
+		row, item := rows[i], idc.NewImportRow(rows[i])
+		report.Add(s.importRow(ctx, req, row, item, lines))
+	}
+
+	return report, nil
+}
+
+func (s *service) importRow(ctx context.Context, req *idc.ImportRequest, row *idc.Row, item *idc.ImportRow, lines map[string]int64) *idc.ImportRow {
+	if err := row.Validate(); err != nil {
+		item.Failed("%s", err)
+		return item
+	}
+
+	// 同一个文件中c_id重复时, 后面的行会覆盖前面的行, 直接视为失败
+	ins := row.Host.Resource
+	if line, ok := lines[ins.Cid]; ok {
+		item.Failed("c_id %s duplicate with line %d", ins.Cid, line)
+		return item
+	}
+	lines[ins.Cid] = row.Line
+
+	ins.CreateBy = req.CreateBy
+	ins.UpdateBy = req.CreateBy
+
+	// 主机的特有属性由主机服务保存, dry run时只需要资源服务比对
+	var (
+		result *resource.SaveResult
+		err    error
+	)
+	if row.IsHost() && !req.DryRun {
+		result, err = s.host.SaveHost(ctx, row.Host)
+	} else {
+		saveReq := resource.NewSaveRequest(ins)
+		if row.IsHost() {
+			saveReq.Describe = row.Host.Describe.DescribeMap()
+		}
+		saveReq.DryRun = req.DryRun
+		result, err = s.resource.Save(ctx, saveReq)
+	}
+	if err != nil {
+		item.Failed("%s", err)
+		return item
+	}
+
+	item.Update(result)
+	return item
+}
@@ -0,0 +1,44 @@
+package impl
+
+import (
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+	"google.golang.org/grpc"
+
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/idc"
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+var (
+	// Service 服务实例
+	svr = &service{}
+)
+
+type service struct {
+	log      logger.Logger
+	resource resource.ServiceServer
+	host     host.ServiceServer
+	idc.UnimplementedServiceServer
+}
+
+func (s *service) Config() error {
+	s.log = zap.L().Named(s.Name())
+	// 资源通用属性和标签由资源服务保存, 主机特有属性由主机服务保存
+	s.resource = app.GetGrpcApp(resource.AppName).(resource.ServiceServer)
+	s.host = app.GetGrpcApp(host.AppName).(host.ServiceServer)
+	return nil
+}
+
+func (s *service) Name() string {
+	return idc.AppName
+}
+
+func (s *service) Registry(server *grpc.Server) {
+	idc.RegisterServiceServer(server, svr)
+}
+
+func init() {
+	app.RegistryGrpcApp(svr)
+}
@@ -0,0 +1,25 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/opengoats/cmdb/apps/idc"
+	"github.com/opengoats/cmdb/common/auth"
+	"github.com/opengoats/goat/exception"
+)
+
+func (s *service) ImportResources(ctx context.Context, req *idc.ImportRequest) (*idc.ImportReport, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("ImportResources").Error(err)
+		return nil, exception.NewBadRequest("validate import request error, %s", err)
+	}
+
+	// 外部请求以认证后的调用方作为导入人
+	if id := auth.FromContext(ctx); id != nil {
+		req.CreateBy = id.Actor()
+	}
+
+	// 逐行导入
+	return s.importResources(ctx, req)
+}
@@ -0,0 +1,294 @@
+package idc
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+var (
+	// 导出文件中由系统维护的列, 导入时忽略, 保证导出的文件可以直接导入
+	ignoredColumns = map[string]bool{
+		"id": true, "status": true, "create_at": true, "create_by": true, "update_at": true, "update_by": true,
+		"delete_at": true, "delete_by": true, "sync_at": true, "secret_id": true,
+	}
+	// 过期时间支持的格式, 也可以直接使用毫秒时间戳
+	expireLayouts = []string{resource.ExportTimeLayout, "2006-01-02"}
+)
+
+// Row 导入文件中的一行, 解析失败时Err不为空, 非主机资源的Describe为空对象
+type Row struct {
+	Line int64
+	Host *host.Host
+	Err  error
+}
+
+func newRow(line int64) *Row {
+	row := &Row{Line: line, Host: host.NewDefaultHost()}
+	row.Host.Resource.ResourceType = resource.Type_HOST
+	return row
+}
+
+// Validate 使用资源和主机上的校验规则校验, 标签需要单独校验
+func (r *Row) Validate() error {
+	if r.Err != nil {
+		return r.Err
+	}
+	if err := r.Host.Validate(); err != nil {
+		return err
+	}
+	if !r.Host.Resource.Vendor.Equal(resource.Vendor_IDC) {
+		return fmt.Errorf("vendor must be %s, but %s", resource.Vendor_IDC, r.Host.Resource.Vendor)
+	}
+	for i := range r.Host.Resource.Tags {
+		if err := r.Host.Resource.Tags[i].Validate(); err != nil {
+			return fmt.Errorf("tag %s invalid, %s", r.Host.Resource.Tags[i].UniqueKey(), err)
+		}
+	}
+	return nil
+}
+
+// IsHost 主机的特有属性由主机服务保存
+func (r *Row) IsHost() bool {
+	return r.Host.Resource.ResourceType.Equal(resource.Type_HOST)
+}
+
+// Parse 解析导入文件, 文件格式错误时返回错误, 单行的错误记录在Row中
+func Parse(format ImportFormat, r io.Reader) ([]*Row, error) {
+	switch format {
+	case ImportFormat_CSV:
+		return ParseCSV(r)
+	case ImportFormat_JSON:
+		return ParseJSON(r)
+	}
+	return nil, fmt.Errorf("unknown import format %s", format)
+}
+
+// ParseJSON 解析主机结构的数组, 资源类型为空时默认为主机
+func ParseJSON(r io.Reader) ([]*Row, error) {
+	items := []json.RawMessage{}
+	if err := json.NewDecoder(r).Decode(&items); err != nil {
+		return nil, fmt.Errorf("decode json array error, %s", err)
+	}
+
+	rows := make([]*Row, 0, len(items))
+	for i := range items {
+		row := newRow(int64(i + 1))
+		if err := json.Unmarshal(items[i], row.Host); err != nil {
+			row.Err = fmt.Errorf("decode json error, %s", err)
+		}
+		if row.Host.Resource == nil {
+			row.Host.Resource = resource.NewDefaultResource()
+		}
+		if row.Host.Describe == nil {
+			row.Host.Describe = host.NewDefaultDescribe()
+		}
+		// IDC只有一个厂商, 没有填写时自动补全
+		row.Host.Resource.Vendor = resource.Vendor_IDC
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// ParseCSV 第一行为列名, 列名与资源和主机的json字段名保持一致
+// 标签可以使用 tag:<key> 列, 也可以使用 tags 列, 格式为 key=value, 多个值以逗号分隔
+// IP和安全组等列表字段以逗号分隔
+func ParseCSV(r io.Reader) ([]*Row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header error, %s", err)
+	}
+	for i := range header {
+		// 去掉Excel导出时带的BOM
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+		if err := validateColumn(header[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	rows := []*Row{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv error, %s", err)
+		}
+		// 空行会被跳过, 行号以文件中的为准
+		line, _ := reader.FieldPos(0)
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		row := newRow(int64(line))
+		row.Host.Resource.Vendor = resource.Vendor_IDC
+		if len(record) != len(header) {
+			row.Err = fmt.Errorf("expect %d columns, but %d", len(header), len(record))
+		} else {
+			row.Err = row.set(header, record)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func validateColumn(column string) error {
+	if strings.HasPrefix(column, resource.ExportTagColumnPrefix) {
+		if strings.TrimPrefix(column, resource.ExportTagColumnPrefix) == "" {
+			return fmt.Errorf("column %s tag key required", column)
+		}
+		return nil
+	}
+	if ignoredColumns[column] || column == "vendor" || column == resource.ExportTagsColumn {
+		return nil
+	}
+	if _, ok := resourceSetters[column]; ok {
+		return nil
+	}
+	if _, ok := describeSetters[column]; ok {
+		return nil
+	}
+	return fmt.Errorf("unknown column %s", column)
+}
+
+// set 按列名设置资源和主机的属性
+func (r *Row) set(header, record []string) error {
+	ins, d := r.Host.Resource, r.Host.Describe
+	for i, column := range header {
+		v := strings.TrimSpace(record[i])
+		switch {
+		case v == "" || ignoredColumns[column]:
+		case column == "vendor":
+			vendor, err := resource.ParseVendorFromString(v)
+			if err != nil {
+				return err
+			}
+			ins.Vendor = vendor
+		case column == resource.ExportTagsColumn:
+			tags, err := ParseTags(v)
+			if err != nil {
+				return err
+			}
+			ins.Tags = append(ins.Tags, tags...)
+		case strings.HasPrefix(column, resource.ExportTagColumnPrefix):
+			key := strings.TrimPrefix(column, resource.ExportTagColumnPrefix)
+			for _, value := range splitList(v) {
+				t := resource.NewDefaultTag()
+				t.Key, t.Value = key, value
+				ins.Tags = append(ins.Tags, t)
+			}
+		default:
+			var err error
+			if set, ok := resourceSetters[column]; ok {
+				err = set(ins, v)
+			} else {
+				err = describeSetters[column](d, v)
+			}
+			if err != nil {
+				return fmt.Errorf("column %s value %s invalid, %s", column, v, err)
+			}
+		}
+	}
+	return nil
+}
+
+var (
+	resourceSetters = map[string]func(*resource.Resource, string) error{
+		"c_id":         func(r *resource.Resource, v string) error { r.Cid = v; return nil },
+		"region":       func(r *resource.Resource, v string) error { r.Region = v; return nil },
+		"zone":         func(r *resource.Resource, v string) error { r.Zone = v; return nil },
+		"domain":       func(r *resource.Resource, v string) error { r.Domain = v; return nil },
+		"namespace":    func(r *resource.Resource, v string) error { r.Namespace = v; return nil },
+		"env":          func(r *resource.Resource, v string) error { r.Env = v; return nil },
+		"category":     func(r *resource.Resource, v string) error { r.Category = v; return nil },
+		"type":         func(r *resource.Resource, v string) error { r.Type = v; return nil },
+		"name":         func(r *resource.Resource, v string) error { r.Name = v; return nil },
+		"description":  func(r *resource.Resource, v string) error { r.Description = v; return nil },
+		"c_status":     func(r *resource.Resource, v string) error { r.CStatus = v; return nil },
+		"sync_account": func(r *resource.Resource, v string) error { r.SyncAccount = v; return nil },
+		"pay_type":     func(r *resource.Resource, v string) error { r.PayType = v; return nil },
+		"public_ip":    func(r *resource.Resource, v string) error { r.PublicIp = splitList(v); return nil },
+		"private_ip":   func(r *resource.Resource, v string) error { r.PrivateIp = splitList(v); return nil },
+		"resource_type": func(r *resource.Resource, v string) (err error) {
+			r.ResourceType, err = resource.ParseTypeFromString(v)
+			return
+		},
+		"usage_mode": func(r *resource.Resource, v string) (err error) {
+			r.UsageMode, err = resource.ParseUsageModeFromString(v)
+			return
+		},
+		"expire_at": func(r *resource.Resource, v string) (err error) {
+			r.ExpireAt, err = ParseExpireAt(v)
+			return
+		},
+	}
+
+	describeSetters = map[string]func(*host.Describe, string) error{
+		"cpu":                        func(d *host.Describe, v string) (err error) { d.Cpu, err = parseInt(v); return },
+		"memory":                     func(d *host.Describe, v string) (err error) { d.Memory, err = parseInt(v); return },
+		"gpu_amount":                 func(d *host.Describe, v string) (err error) { d.GpuAmount, err = parseInt(v); return },
+		"gpu_spec":                   func(d *host.Describe, v string) error { d.GpuSpec = v; return nil },
+		"os_type":                    func(d *host.Describe, v string) error { d.OsType = v; return nil },
+		"os_name":                    func(d *host.Describe, v string) error { d.OsName = v; return nil },
+		"serial_number":              func(d *host.Describe, v string) error { d.SerialNumber = v; return nil },
+		"image_id":                   func(d *host.Describe, v string) error { d.ImageId = v; return nil },
+		"key_pair_name":              func(d *host.Describe, v string) error { d.KeyPairName = v; return nil },
+		"security_groups":            func(d *host.Describe, v string) error { d.SecurityGroups = splitList(v); return nil },
+		"internet_max_bandwidth_out": func(d *host.Describe, v string) (err error) { d.InternetMaxBandwidthOut, err = parseInt(v); return },
+		"internet_max_bandwidth_in":  func(d *host.Describe, v string) (err error) { d.InternetMaxBandwidthIn, err = parseInt(v); return },
+	}
+)
+
+// ParseTags 解析 key=value 格式的标签, 多个标签以逗号分隔
+func ParseTags(s string) ([]*resource.Tag, error) {
+	tags := []*resource.Tag{}
+	for _, kv := range splitList(s) {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return nil, fmt.Errorf("tag %s format must be key=value", kv)
+		}
+		t := resource.NewDefaultTag()
+		t.Key, t.Value = strings.TrimSpace(k), strings.TrimSpace(v)
+		tags = append(tags, t)
+	}
+	return tags, nil
+}
+
+// ParseExpireAt 解析过期时间, 支持毫秒时间戳和日期格式, 日期使用本地时区
+func ParseExpireAt(s string) (int64, error) {
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return ms, nil
+	}
+	for _, layout := range expireLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t.UnixMilli(), nil
+		}
+	}
+	return 0, fmt.Errorf("format must be unix milliseconds or %s", expireLayouts)
+}
+
+func parseInt(s string) (int64, error) {
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func splitList(s string) []string {
+	items := []string{}
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			items = append(items, v)
+		}
+	}
+	return items
+}
@@ -0,0 +1,121 @@
+package idc_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opengoats/cmdb/apps/idc"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCSV(t *testing.T) {
+	should := assert.New(t)
+
+	data := "\ufeffid,c_id,name,resource_type,private_ip,cpu,memory,expire_at,tag:app,tags\n" +
+		`r1,host-01,web-01,HOST,"10.0.0.1,10.0.0.2",8,16384,2024-01-02,"web,api",env=prod` + "\n" +
+		"\n" +
+		",rds-01,mysql,RDS,10.0.1.1,,,1700000000000,,\n" +
+		",,no-cid,HOST,,,,,,\n" +
+		"r4,host-04,bad-cpu,HOST,,eight,,,,\n" +
+		"short,row\n"
+
+	rows, err := idc.ParseCSV(strings.NewReader(data))
+	if !should.NoError(err) {
+		return
+	}
+	should.Len(rows, 5)
+
+	// 主机
+	h := rows[0]
+	should.Equal(int64(2), h.Line)
+	should.NoError(h.Validate())
+	should.True(h.IsHost())
+	should.Equal("", h.Host.Resource.Id)
+	should.Equal(resource.Vendor_IDC, h.Host.Resource.Vendor)
+	should.Equal([]string{"10.0.0.1", "10.0.0.2"}, h.Host.Resource.PrivateIp)
+	should.Equal(int64(8), h.Host.Describe.Cpu)
+	should.Equal(int64(16384), h.Host.Describe.Memory)
+	should.NotZero(h.Host.Resource.ExpireAt)
+	should.Equal("app=api,app=web,env=prod", h.Host.Resource.TagsToString())
+
+	// 非主机资源, 空行被跳过
+	rds := rows[1]
+	should.Equal(int64(4), rds.Line)
+	should.NoError(rds.Validate())
+	should.False(rds.IsHost())
+	should.Equal(resource.Type_RDS, rds.Host.Resource.ResourceType)
+	should.Equal(int64(1700000000000), rds.Host.Resource.ExpireAt)
+
+	// 使用资源上的校验规则, c_id必填
+	should.ErrorContains(rows[2].Validate(), "Cid")
+	should.ErrorContains(rows[3].Validate(), "cpu")
+	should.ErrorContains(rows[4].Validate(), "columns")
+}
+
+func TestParseCSVHeader(t *testing.T) {
+	should := assert.New(t)
+
+	_, err := idc.ParseCSV(strings.NewReader("c_id,unknown\nh1,x\n"))
+	should.ErrorContains(err, "unknown")
+
+	_, err = idc.ParseCSV(strings.NewReader("c_id,tag:\nh1,x\n"))
+	should.Error(err)
+
+	rows, err := idc.ParseCSV(strings.NewReader("c_id,vendor\nh1,ALIYUN\n"))
+	if should.NoError(err) {
+		should.ErrorContains(rows[0].Validate(), "vendor")
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	should := assert.New(t)
+
+	data := `[
+		{"resource": {"c_id": "host-01", "name": "web-01", "tags": [{"key": "app", "value": "web"}]}, "describe": {"cpu": 4}},
+		{"resource": {"c_id": "rds-02", "resource_type": "RDS"}},
+		{"resource": {"name": "no-cid"}},
+		{"resource": {"c_id": "bad", "resource_type": "UNKNOWN"}},
+		{"resource": {"c_id": "tag", "tags": [{"key": "app"}]}}
+	]`
+	rows, err := idc.Parse(idc.ImportFormat_JSON, strings.NewReader(data))
+	if !should.NoError(err) {
+		return
+	}
+	should.Len(rows, 5)
+
+	should.NoError(rows[0].Validate())
+	should.True(rows[0].IsHost())
+	should.Equal(int64(4), rows[0].Host.Describe.Cpu)
+	should.Equal(resource.Vendor_IDC, rows[0].Host.Resource.Vendor)
+
+	should.NoError(rows[1].Validate())
+	should.Equal(resource.Type_RDS, rows[1].Host.Resource.ResourceType)
+
+	should.Error(rows[2].Validate())
+	should.Error(rows[3].Validate())
+	should.ErrorContains(rows[4].Validate(), "tag")
+
+	_, err = idc.Parse(idc.ImportFormat_JSON, strings.NewReader(`{"c_id": "h1"}`))
+	should.Error(err)
+}
+
+func TestImportReport(t *testing.T) {
+	should := assert.New(t)
+
+	report := idc.NewImportReport(true)
+	for _, status := range []resource.SaveStatus{
+		resource.SaveStatus_CREATED,
+		resource.SaveStatus_CREATED,
+		resource.SaveStatus_UPDATED,
+		resource.SaveStatus_UNCHANGED,
+		resource.SaveStatus_FAILED,
+	} {
+		report.Add(&idc.ImportRow{Status: status})
+	}
+	should.Equal(int64(5), report.Total)
+	should.Equal(int64(2), report.Created)
+	should.Equal(int64(1), report.Updated)
+	should.Equal(int64(1), report.Unchanged)
+	should.Equal(int64(1), report.Failed)
+}
@@ -0,0 +1,5 @@
+package reminder
+
+const (
+	AppName = "reminder"
+)
@@ -0,0 +1,118 @@
+package reminder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"text/template"
+	"time"
+
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+const (
+	// DefaultTemplate 默认的提醒消息, JSON格式
+	DefaultTemplate = `{"window_days":{{.WindowDays}},"send_at":{{.SendAt.UnixMilli}},"total":{{len .Resources}},"resources":[
+{{- range $i, $r := .Resources}}{{if $i}},{{end}}{"id":{{json $r.Id}},"c_id":{{json $r.Cid}},"name":{{json $r.Name}},` +
+		`"vendor":"{{$r.Vendor}}","resource_type":"{{$r.ResourceType}}","region":{{json $r.Region}},` +
+		`"namespace":{{json $r.Namespace}},"env":{{json $r.Env}},"pay_type":{{json $r.PayType}},` +
+		`"expire_at":{{$r.ExpireAt}},"expire_in_days":{{$.ExpireInDays $r}}}{{end}}]}`
+)
+
+var (
+	funcs = template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}
+)
+
+// Event 一个提醒窗口内需要提醒的资源
+type Event struct {
+	// 提醒窗口, 单位天
+	WindowDays int64
+	// 提醒时间
+	SendAt time.Time
+	// 需要提醒的资源
+	Resources []*resource.Resource
+}
+
+func NewEvent(windowDays int64, sendAt time.Time) *Event {
+	return &Event{
+		WindowDays: windowDays,
+		SendAt:     sendAt,
+		Resources:  []*resource.Resource{},
+	}
+}
+
+func (e *Event) Add(item *resource.Resource) {
+	e.Resources = append(e.Resources, item)
+}
+
+// ExpireInDays 距离过期的剩余天数, 不足一天按一天计算
+func (e *Event) ExpireInDays(r *resource.Resource) int64 {
+	day := 24 * time.Hour
+	return int64((r.ExpireIn(e.SendAt) + day - 1) / day)
+}
+
+// ParseTemplate 加载消息模版, 文件为空时使用默认模版
+func ParseTemplate(file string) (*template.Template, error) {
+	text := DefaultTemplate
+	if file != "" {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read reminder template error, %s", err)
+		}
+		text = string(b)
+	}
+	return template.New(AppName).Funcs(funcs).Parse(text)
+}
+
+// Render 按模版生成提醒消息
+func (e *Event) Render(tpl *template.Template) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	if err := tpl.Execute(buf, e); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Windows 提醒窗口, 按天数从小到大排序, 忽略非正数和重复的窗口
+type Windows []int64
+
+func NewWindows(days []int64) Windows {
+	w := Windows{}
+	exist := map[int64]bool{}
+	for _, d := range days {
+		if d > 0 && !exist[d] {
+			exist[d] = true
+			w = append(w, d)
+		}
+	}
+	sort.Slice(w, func(i, j int) bool { return w[i] < w[j] })
+	return w
+}
+
+// Max 最大的窗口, 用于查询即将过期的资源
+func (w Windows) Max() int64 {
+	if len(w) == 0 {
+		return 0
+	}
+	return w[len(w)-1]
+}
+
+// Match 资源落在的最小窗口, 比如窗口为30/7/1天, 剩余5天时只在7天的窗口内提醒
+func (w Windows) Match(expireIn time.Duration) (int64, bool) {
+	if expireIn <= 0 {
+		return 0, false
+	}
+	for _, d := range w {
+		if expireIn <= time.Duration(d)*24*time.Hour {
+			return d, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,73 @@
+package reminder_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opengoats/cmdb/apps/reminder"
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+func TestWindowsMatch(t *testing.T) {
+	should := assert.New(t)
+
+	w := reminder.NewWindows([]int64{7, 30, 1, 7, 0})
+	should.Equal(reminder.Windows{1, 7, 30}, w)
+	should.Equal(int64(30), w.Max())
+
+	day := 24 * time.Hour
+	cases := []struct {
+		expireIn time.Duration
+		window   int64
+		ok       bool
+	}{
+		{20 * time.Hour, 1, true},
+		{5 * day, 7, true},
+		{7 * day, 7, true},
+		{29 * day, 30, true},
+		{31 * day, 0, false},
+		{-time.Hour, 0, false},
+	}
+	for _, c := range cases {
+		window, ok := w.Match(c.expireIn)
+		should.Equal(c.ok, ok, c.expireIn)
+		should.Equal(c.window, window, c.expireIn)
+	}
+}
+
+func TestRenderDefaultTemplate(t *testing.T) {
+	should := assert.New(t)
+
+	tpl, err := reminder.ParseTemplate("")
+	if !should.NoError(err) {
+		return
+	}
+
+	now := time.Now()
+	e := reminder.NewEvent(7, now)
+	ins := resource.NewDefaultResource()
+	ins.Id = "r1"
+	ins.Name = `db "prod"`
+	ins.Vendor = resource.Vendor_ALIYUN
+	ins.ResourceType = resource.Type_RDS
+	ins.ExpireAt = now.Add(5*24*time.Hour + time.Hour).UnixMilli()
+	e.Add(ins)
+
+	body, err := e.Render(tpl)
+	if !should.NoError(err) {
+		return
+	}
+	data := map[string]interface{}{}
+	if should.NoError(json.Unmarshal(body, &data), string(body)) {
+		should.Equal(float64(7), data["window_days"])
+		should.Equal(float64(1), data["total"])
+		r := data["resources"].([]interface{})[0].(map[string]interface{})
+		should.Equal(`db "prod"`, r["name"])
+		should.Equal("ALIYUN", r["vendor"])
+		should.Equal("RDS", r["resource_type"])
+		should.Equal(float64(6), r["expire_in_days"])
+	}
+}
@@ -0,0 +1,251 @@
+package reminder
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+	"github.com/opengoats/goat/sqlbuilder"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/common/auth"
+	"github.com/opengoats/cmdb/conf"
+)
+
+const (
+	// 每次查询即将过期资源的数量
+	scanPageSize = 100
+	// 调用Webhook的超时时间
+	webhookTimeout = 10 * time.Second
+)
+
+var (
+	svr = &scheduler{}
+)
+
+// scheduler 定时扫描即将过期的包年包月资源, 按提醒窗口调用Webhook发送续费提醒
+type scheduler struct {
+	db       *sql.DB
+	log      logger.Logger
+	webhook  string
+	interval time.Duration
+	windows  Windows
+	payTypes []string
+	tpl      *template.Template
+	client   *http.Client
+}
+
+func (s *scheduler) Config() error {
+	c := conf.C().Reminder
+	if c.Webhook == "" {
+		return nil
+	}
+
+	s.log = zap.L().Named(s.Name())
+	s.webhook = c.Webhook
+	s.interval = time.Duration(c.Interval) * time.Minute
+	if s.interval <= 0 {
+		return fmt.Errorf("reminder interval must be greater than 0")
+	}
+	s.windows = NewWindows(c.Windows)
+	if len(s.windows) == 0 {
+		return fmt.Errorf("reminder windows required")
+	}
+	s.payTypes = c.PayTypes
+	if len(s.payTypes) == 0 {
+		s.payTypes = resource.PrepaidPayTypes
+	}
+	tpl, err := ParseTemplate(c.TemplateFile)
+	if err != nil {
+		return err
+	}
+	s.tpl = tpl
+	s.client = &http.Client{Timeout: webhookTimeout}
+
+	db, err := conf.C().MySQL.GetDB()
+	if err != nil {
+		return err
+	}
+	s.db = db
+
+	go s.run()
+	s.log.Infof("reminder started, windows: %v days, interval: %s", s.windows, s.interval)
+	return nil
+}
+
+func (s *scheduler) Name() string {
+	return AppName
+}
+
+// run 启动后立即扫描一次, 之后按间隔扫描, 避免重启后要等一个间隔才提醒
+func (s *scheduler) run() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	// 定时扫描是服务内部调用, 需要查询所有空间的资源
+	ctx := auth.NewInternalContext(context.Background())
+	for {
+		if err := s.scan(ctx); err != nil {
+			s.log.Errorf("scan expiring resources error, %s", err)
+		}
+		<-ticker.C
+	}
+}
+
+// scan 查询最大窗口内过期的资源, 每个资源只在所落在的最小窗口内提醒一次
+func (s *scheduler) scan(ctx context.Context) error {
+	// 内部服务先于GRPC服务加载, 需要在运行时查询资源服务
+	svc := app.GetGrpcApp(resource.AppName).(resource.ServiceServer)
+
+	now := time.Now()
+	events := map[int64]*Event{}
+	req := resource.NewExpiringResourcesRequest()
+	req.WithinDays = s.windows.Max()
+	req.PayTypes = s.payTypes
+	req.Page.PageSize = scanPageSize
+	for {
+		set, err := svc.ExpiringResources(ctx, req)
+		if err != nil {
+			return err
+		}
+		for i := range set.Items {
+			w, ok := s.windows.Match(set.Items[i].ExpireIn(now))
+			if !ok {
+				continue
+			}
+			if _, ok := events[w]; !ok {
+				events[w] = NewEvent(w, now)
+			}
+			events[w].Add(set.Items[i])
+		}
+		if len(set.Items) < scanPageSize {
+			break
+		}
+		req.Page.PageNumber++
+	}
+
+	for _, w := range s.windows {
+		e, ok := events[w]
+		if !ok {
+			continue
+		}
+		if err := s.remind(ctx, e); err != nil {
+			s.log.Errorf("send %d days reminder error, %s", w, err)
+		}
+	}
+	return nil
+}
+
+// remind 过滤掉已经提醒过的资源, 发送成功后记录提醒, 发送失败时下次扫描重试
+func (s *scheduler) remind(ctx context.Context, e *Event) error {
+	sent, err := s.querySent(ctx, e)
+	if err != nil {
+		return err
+	}
+	pending := NewEvent(e.WindowDays, e.SendAt)
+	for _, r := range e.Resources {
+		if !sent[sentKey(r.Id, r.ExpireAt)] {
+			pending.Add(r)
+		}
+	}
+	if len(pending.Resources) == 0 {
+		return nil
+	}
+
+	if err := s.send(ctx, pending); err != nil {
+		return err
+	}
+	s.log.Infof("send %d days reminder for %d resources", pending.WindowDays, len(pending.Resources))
+	return s.saveSent(ctx, pending)
+}
+
+func (s *scheduler) send(ctx context.Context, e *Event) error {
+	body, err := e.Render(s.tpl)
+	if err != nil {
+		return fmt.Errorf("render reminder template error, %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("webhook response status %d, %s", resp.StatusCode, msg)
+	}
+	return nil
+}
+
+func sentKey(resourceId string, expireAt int64) string {
+	return fmt.Sprintf("%s/%d", resourceId, expireAt)
+}
+
+func (s *scheduler) querySent(ctx context.Context, e *Event) (map[string]bool, error) {
+	ids := make([]string, 0, len(e.Resources))
+	for _, r := range e.Resources {
+		ids = append(ids, r.Id)
+	}
+
+	query := sqlbuilder.NewQuery(sqlQueryReminder)
+	query.Where("window_days = ?", e.WindowDays)
+	query.Where("resource_id IN (?"+strings.Repeat(",?", len(ids)-1)+")", resource.StringsToArgs(ids)...)
+
+	querySQL, args := query.Build()
+	s.log.Named("QueryReminder").Debugf("sql: %s; %v", querySQL, args)
+	rows, err := s.db.QueryContext(ctx, querySQL, args...)
+	if err != nil {
+		return nil, exception.NewInternalServerError("query reminder err %s", err)
+	}
+	defer rows.Close()
+
+	sent := map[string]bool{}
+	for rows.Next() {
+		var id string
+		var expireAt int64
+		if err := rows.Scan(&id, &expireAt); err != nil {
+			return nil, exception.NewInternalServerError("query reminder err %s", err)
+		}
+		sent[sentKey(id, expireAt)] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, exception.NewInternalServerError("query reminder err %s", err)
+	}
+	return sent, nil
+}
+
+func (s *scheduler) saveSent(ctx context.Context, e *Event) error {
+	s.log.Named("SaveReminder").Debugf("sql: %s", sqlInsertReminder)
+	stmt, err := s.db.PrepareContext(ctx, sqlInsertReminder)
+	if err != nil {
+		return exception.NewInternalServerError("save reminder err %s", err)
+	}
+	defer stmt.Close()
+
+	for _, r := range e.Resources {
+		if _, err := stmt.ExecContext(ctx, r.Id, e.WindowDays, r.ExpireAt, e.SendAt.UnixMilli()); err != nil {
+			return exception.NewInternalServerError("save reminder err %s", err)
+		}
+	}
+	return nil
+}
+
+func init() {
+	app.RegistryInternalApp(svr)
+}
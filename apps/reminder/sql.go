@@ -0,0 +1,7 @@
+package reminder
+
+const (
+	// 同一个资源在同一个窗口内只提醒一次, 续费后过期时间变化会重新提醒
+	sqlInsertReminder = `INSERT IGNORE INTO resource_reminder (resource_id,window_days,expire_at,send_at) VALUES (?,?,?,?)`
+	sqlQueryReminder  = `SELECT resource_id,expire_at FROM resource_reminder`
+)
@@ -0,0 +1,179 @@
+package resource
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/sqlbuilder"
+)
+
+const (
+	// AggregateTagPrefix 按标签的值分组, 比如 tag:app
+	AggregateTagPrefix = "tag:"
+)
+
+const (
+	// 先按搜索条件找到资源Id, 避免标签JOIN导致主机的CPU和内存被重复累加
+	sqlAggregateResourceId = `SELECT DISTINCT r.id FROM resource r %s JOIN resource_tag t ON r.id = t.resource_id`
+	// 主机之外的资源没有CPU和内存, 使用LEFT JOIN
+	sqlAggregateResource = `SELECT %s,COUNT(*),IFNULL(SUM(h.cpu),0),IFNULL(SUM(h.memory),0)
+	FROM resource r LEFT JOIN resource_host h ON r.id = h.resource_id%s`
+	// 资源有多个相同key的标签时, 会被统计到多个分组中
+	sqlAggregateTagJoin = ` LEFT JOIN resource_tag %[1]s ON r.id = %[1]s.resource_id AND %[1]s.t_key = ?`
+)
+
+var (
+	// AggregateDimensions 支持分组的资源属性, 以及对应的字段
+	AggregateDimensions = map[string]string{
+		"vendor":        "r.vendor",
+		"region":        "r.region",
+		"zone":          "r.zone",
+		"resource_type": "r.resource_type",
+		"namespace":     "r.namespace",
+		"env":           "r.env",
+		"c_status":      "r.c_status",
+		"pay_type":      "IFNULL(r.pay_type,'')",
+	}
+)
+
+func NewAggregateRequest(groupBy ...string) *AggregateRequest {
+	return &AggregateRequest{
+		Filter:  NewSearchRequest(),
+		GroupBy: groupBy,
+	}
+}
+
+// NewAggregateRequestFromHTTP 过滤条件与搜索接口的Query参数相同, group_by以逗号分隔
+func NewAggregateRequestFromHTTP(r *http.Request) (*AggregateRequest, error) {
+	filter, err := NewSearchRequestFromHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := NewAggregateRequest()
+	req.Filter = filter
+	for _, dim := range strings.Split(r.URL.Query().Get("group_by"), ",") {
+		if dim = strings.TrimSpace(dim); dim != "" {
+			req.GroupBy = append(req.GroupBy, dim)
+		}
+	}
+	return req, nil
+}
+
+func (r *AggregateRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+	if r.Filter != nil {
+		if err := r.Filter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, dim := range r.GroupBy {
+		if seen[dim] {
+			return fmt.Errorf("group by %s duplicated", dim)
+		}
+		seen[dim] = true
+
+		if strings.HasPrefix(dim, AggregateTagPrefix) {
+			if strings.TrimPrefix(dim, AggregateTagPrefix) == "" {
+				return fmt.Errorf("group by %s tag key required", dim)
+			}
+			continue
+		}
+		if _, ok := AggregateDimensions[dim]; !ok {
+			return fmt.Errorf("unknown group by %s, must be one of %s or %s<key>", dim, aggregateDimensionNames(), AggregateTagPrefix)
+		}
+	}
+	return nil
+}
+
+func aggregateDimensionNames() []string {
+	names := make([]string, 0, len(AggregateDimensions))
+	for name := range AggregateDimensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewAggregateQuery 构建统计语句, 每个分组维度一列, 之后依次为数量, CPU和内存
+func NewAggregateQuery(req *AggregateRequest) (string, []interface{}, error) {
+	filter := req.Filter
+	if filter == nil {
+		filter = NewSearchRequest()
+	}
+
+	// 过滤条件编译为资源Id的子查询
+	idQuery, _, err := NewSearchQuery(sqlAggregateResourceId, filter)
+	if err != nil {
+		return "", nil, err
+	}
+	idSQL, idArgs := idQuery.Build()
+	idSQL = strings.TrimSuffix(strings.TrimSpace(idSQL), ";")
+
+	columns, joins, joinArgs := []string{}, "", []interface{}{}
+	for i, dim := range req.GroupBy {
+		if key := strings.TrimPrefix(dim, AggregateTagPrefix); key != dim {
+			alias := fmt.Sprintf("g%d", i)
+			joins += fmt.Sprintf(sqlAggregateTagJoin, alias)
+			joinArgs = append(joinArgs, key)
+			columns = append(columns, fmt.Sprintf("IFNULL(%s.t_value,'')", alias))
+			continue
+		}
+		column, ok := AggregateDimensions[dim]
+		if !ok {
+			return "", nil, exception.NewBadRequest("unknown group by %s", dim)
+		}
+		columns = append(columns, column)
+	}
+	if len(columns) == 0 {
+		return "", nil, exception.NewBadRequest("group by required")
+	}
+
+	query := sqlbuilder.NewQuery(fmt.Sprintf(sqlAggregateResource, strings.Join(columns, ","), joins))
+	query.Where("r.id IN ("+idSQL+")", idArgs...)
+	query.GroupBy(strings.Join(columns, ","))
+	query.Order("COUNT(*)").Desc()
+	stmt, args := query.Build()
+
+	// JOIN中的参数在WHERE条件之前
+	return stmt, append(joinArgs, args...), nil
+}
+
+func NewAggregateResult(groupBy []string) *AggregateResult {
+	return &AggregateResult{
+		GroupBy: groupBy,
+		Buckets: []*AggregateBucket{},
+	}
+}
+
+// NewAggregateBucket 枚举类型的维度转换为名称, 与资源json中的格式保持一致
+func NewAggregateBucket(groupBy, values []string) *AggregateBucket {
+	b := &AggregateBucket{
+		Keys: make(map[string]string, len(groupBy)),
+	}
+	for i, dim := range groupBy {
+		v := values[i]
+		if n, err := strconv.ParseInt(v, 10, 32); err == nil {
+			switch dim {
+			case "vendor":
+				v = strings.ToUpper(Vendor(n).String())
+			case "resource_type":
+				v = strings.ToUpper(Type(n).String())
+			}
+		}
+		b.Keys[dim] = v
+	}
+	return b
+}
+
+func (s *AggregateResult) Add(item *AggregateBucket) {
+	s.Buckets = append(s.Buckets, item)
+}
@@ -0,0 +1,64 @@
+package resource_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAggregateQuery(t *testing.T) {
+	should := assert.New(t)
+
+	req := resource.NewAggregateRequest("vendor", "tag:app")
+	req.Filter.Env = "prod"
+	should.NoError(req.Validate())
+
+	stmt, args, err := resource.NewAggregateQuery(req)
+	if should.NoError(err) {
+		should.Contains(stmt, "SELECT r.vendor,IFNULL(g1.t_value,''),COUNT(*),IFNULL(SUM(h.cpu),0),IFNULL(SUM(h.memory),0)")
+		should.Contains(stmt, "LEFT JOIN resource_tag g1 ON r.id = g1.resource_id AND g1.t_key = ?")
+		should.Contains(stmt, "WHERE r.id IN (SELECT DISTINCT r.id FROM resource r LEFT JOIN resource_tag t ON r.id = t.resource_id  WHERE r.status > 0 AND r.env = ?  )")
+		should.Contains(stmt, "GROUP BY r.vendor,IFNULL(g1.t_value,'') ORDER BY COUNT(*) DESC")
+		// JOIN中的标签key在过滤条件的参数之前
+		should.Equal([]interface{}{"app", "prod"}, args)
+	}
+}
+
+func TestAggregateRequestValidate(t *testing.T) {
+	should := assert.New(t)
+
+	should.Error(resource.NewAggregateRequest().Validate())
+	should.Error(resource.NewAggregateRequest("vendor", "region", "zone", "env").Validate())
+	should.Error(resource.NewAggregateRequest("vendor", "vendor").Validate())
+	should.Error(resource.NewAggregateRequest("name").Validate())
+	should.Error(resource.NewAggregateRequest("tag:").Validate())
+	should.NoError(resource.NewAggregateRequest("pay_type", "tag:env").Validate())
+
+	req := resource.NewAggregateRequest("region")
+	req.Filter.Cidr = "10.0.0.0"
+	should.Error(req.Validate())
+}
+
+func TestNewAggregateRequestFromHTTP(t *testing.T) {
+	should := assert.New(t)
+
+	req, err := resource.NewAggregateRequestFromHTTP(httptest.NewRequest("GET", "/aggregate?group_by=vendor,+tag:app,&vendor=aliyun", nil))
+	if should.NoError(err) {
+		should.Equal([]string{"vendor", "tag:app"}, req.GroupBy)
+		should.Equal(resource.Vendor_ALIYUN, *req.Filter.Vendor)
+	}
+}
+
+func TestNewAggregateBucket(t *testing.T) {
+	should := assert.New(t)
+
+	b := resource.NewAggregateBucket([]string{"vendor", "resource_type", "region", "tag:app"}, []string{"0", "1", "cn-hangzhou", "123"})
+	should.Equal(map[string]string{
+		"vendor":        "ALIYUN",
+		"resource_type": "RDS",
+		"region":        "cn-hangzhou",
+		"tag:app":       "123",
+	}, b.Keys)
+}
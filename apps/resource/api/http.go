@@ -1 +1,253 @@
 package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/common/auth"
+	"github.com/opengoats/cmdb/common/version"
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/http/response"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service resource.ServiceServer
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(resource.AppName)
+	h.service = app.GetGrpcApp(resource.AppName).(resource.ServiceServer)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return resource.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"resources"}
+
+	ws.Route(ws.GET("/").To(h.SearchResource).
+		Doc("search resources").
+		Param(ws.QueryParameter("page_size", "page size").DataType("integer").DefaultValue("20")).
+		Param(ws.QueryParameter("page_number", "page number").DataType("integer").DefaultValue("1")).
+		Param(ws.QueryParameter("domain", "resource domain").DataType("string")).
+		Param(ws.QueryParameter("namespace", "resource namespace").DataType("string")).
+		Param(ws.QueryParameter("env", "resource env").DataType("string")).
+		Param(ws.QueryParameter("usage_mode", "usage mode").DataType("string").PossibleValues([]string{"SHARED", "MONOPOLY"})).
+		Param(ws.QueryParameter("vendor", "resource vendor").DataType("string").PossibleValues([]string{"ALIYUN", "TENCENT", "HUAWEI", "IDC"})).
+		Param(ws.QueryParameter("sync_account", "sync account").DataType("string")).
+		Param(ws.QueryParameter("type", "resource type").DataType("string")).
+		Param(ws.QueryParameter("status", "vendor status").DataType("string")).
+		Param(ws.QueryParameter("tag", "tag selectors, e.g. app=web,env in (prod,pre),!deprecated, can be repeated").DataType("string").AllowMultiple(true)).
+		Param(ws.QueryParameter("with_tags", "return resource tags").DataType("boolean")).
+		Param(ws.QueryParameter("keywords", "match name, id, description or ip").DataType("string")).
+		Param(ws.QueryParameter("exact_match", "keywords exact match").DataType("boolean")).
+		Param(ws.QueryParameter("include_released", "include resources released at vendor").DataType("boolean")).
+		Param(ws.QueryParameter("ip", "resources own the ip").DataType("string")).
+		Param(ws.QueryParameter("cidr", "resources own ip in the cidr, e.g. 10.0.0.0/8").DataType("string")).
+		Param(ws.QueryParameter("ip_range", "resources own ip in the range, e.g. 10.0.0.1-10.0.0.100").DataType("string")).
+		Param(ws.QueryParameter("ip_type", "only match the ip type").DataType("string").PossibleValues([]string{"PRIVATE", "PUBLIC"})).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, only return resources visible to the caller").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Metadata("action", "list").
+		Writes(response.NewMessage(resource.ResourceSet{})).
+		Returns(200, "OK", resource.ResourceSet{}).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.GET("/stream").To(h.StreamSearchResource).
+		Doc("stream all matched resources as NDJSON, one resource per line, order by id").
+		Param(ws.QueryParameter("page_size", "batch size of each query, page_number is ignored").DataType("integer").DefaultValue("500")).
+		Param(ws.QueryParameter("domain", "resource domain").DataType("string")).
+		Param(ws.QueryParameter("namespace", "resource namespace").DataType("string")).
+		Param(ws.QueryParameter("env", "resource env").DataType("string")).
+		Param(ws.QueryParameter("usage_mode", "usage mode").DataType("string").PossibleValues([]string{"SHARED", "MONOPOLY"})).
+		Param(ws.QueryParameter("vendor", "resource vendor").DataType("string").PossibleValues([]string{"ALIYUN", "TENCENT", "HUAWEI", "IDC"})).
+		Param(ws.QueryParameter("sync_account", "sync account").DataType("string")).
+		Param(ws.QueryParameter("type", "resource type").DataType("string")).
+		Param(ws.QueryParameter("status", "vendor status").DataType("string")).
+		Param(ws.QueryParameter("tag", "tag selectors, e.g. app=web,env in (prod,pre),!deprecated, can be repeated").DataType("string").AllowMultiple(true)).
+		Param(ws.QueryParameter("with_tags", "return resource tags").DataType("boolean")).
+		Param(ws.QueryParameter("keywords", "match name, id, description or ip").DataType("string")).
+		Param(ws.QueryParameter("exact_match", "keywords exact match").DataType("boolean")).
+		Param(ws.QueryParameter("include_released", "include resources released at vendor").DataType("boolean")).
+		Param(ws.QueryParameter("ip", "resources own the ip").DataType("string")).
+		Param(ws.QueryParameter("cidr", "resources own ip in the cidr, e.g. 10.0.0.0/8").DataType("string")).
+		Param(ws.QueryParameter("ip_range", "resources own ip in the range, e.g. 10.0.0.1-10.0.0.100").DataType("string")).
+		Param(ws.QueryParameter("ip_type", "only match the ip type").DataType("string").PossibleValues([]string{"PRIVATE", "PUBLIC"})).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, only return resources visible to the caller").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Metadata("action", "list").
+		Returns(200, "OK", resource.Resource{}).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.GET("/export").To(h.ExportResource).
+		Doc("export all matched resources as csv or xlsx file, order by id").
+		Param(ws.QueryParameter("format", "export file format").DataType("string").PossibleValues([]string{"csv", "xlsx"}).DefaultValue("csv")).
+		Param(ws.QueryParameter("columns", "export columns separated by comma, tag:<key> for a tag key, tags for all tags in one column").DataType("string")).
+		Param(ws.QueryParameter("page_size", "batch size of each query, page_number is ignored").DataType("integer").DefaultValue("500")).
+		Param(ws.QueryParameter("domain", "resource domain").DataType("string")).
+		Param(ws.QueryParameter("namespace", "resource namespace").DataType("string")).
+		Param(ws.QueryParameter("env", "resource env").DataType("string")).
+		Param(ws.QueryParameter("usage_mode", "usage mode").DataType("string").PossibleValues([]string{"SHARED", "MONOPOLY"})).
+		Param(ws.QueryParameter("vendor", "resource vendor").DataType("string").PossibleValues([]string{"ALIYUN", "TENCENT", "HUAWEI", "IDC"})).
+		Param(ws.QueryParameter("sync_account", "sync account").DataType("string")).
+		Param(ws.QueryParameter("type", "resource type").DataType("string")).
+		Param(ws.QueryParameter("status", "vendor status").DataType("string")).
+		Param(ws.QueryParameter("tag", "tag selectors, e.g. app=web,env in (prod,pre),!deprecated, can be repeated").DataType("string").AllowMultiple(true)).
+		Param(ws.QueryParameter("with_tags", "return resource tags").DataType("boolean")).
+		Param(ws.QueryParameter("keywords", "match name, id, description or ip").DataType("string")).
+		Param(ws.QueryParameter("exact_match", "keywords exact match").DataType("boolean")).
+		Param(ws.QueryParameter("include_released", "include resources released at vendor").DataType("boolean")).
+		Param(ws.QueryParameter("ip", "resources own the ip").DataType("string")).
+		Param(ws.QueryParameter("cidr", "resources own ip in the cidr, e.g. 10.0.0.0/8").DataType("string")).
+		Param(ws.QueryParameter("ip_range", "resources own ip in the range, e.g. 10.0.0.1-10.0.0.100").DataType("string")).
+		Param(ws.QueryParameter("ip_type", "only match the ip type").DataType("string").PossibleValues([]string{"PRIVATE", "PUBLIC"})).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, only return resources visible to the caller").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Metadata("action", "list").
+		Returns(200, "OK", nil).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.GET("/aggregate").To(h.Aggregate).
+		Doc("count resources and sum host cpu and memory, group by resource fields or tag values, order by count").
+		Param(ws.QueryParameter("group_by", "group by dimensions separated by comma, at most 3, tag:<key> for a tag key").DataType("string").Required(true)).
+		Param(ws.QueryParameter("domain", "resource domain").DataType("string")).
+		Param(ws.QueryParameter("namespace", "resource namespace").DataType("string")).
+		Param(ws.QueryParameter("env", "resource env").DataType("string")).
+		Param(ws.QueryParameter("usage_mode", "usage mode").DataType("string").PossibleValues([]string{"SHARED", "MONOPOLY"})).
+		Param(ws.QueryParameter("vendor", "resource vendor").DataType("string").PossibleValues([]string{"ALIYUN", "TENCENT", "HUAWEI", "IDC"})).
+		Param(ws.QueryParameter("sync_account", "sync account").DataType("string")).
+		Param(ws.QueryParameter("type", "resource type").DataType("string")).
+		Param(ws.QueryParameter("status", "vendor status").DataType("string")).
+		Param(ws.QueryParameter("tag", "tag selectors, e.g. app=web,env in (prod,pre),!deprecated, can be repeated").DataType("string").AllowMultiple(true)).
+		Param(ws.QueryParameter("keywords", "match name, id, description or ip").DataType("string")).
+		Param(ws.QueryParameter("exact_match", "keywords exact match").DataType("boolean")).
+		Param(ws.QueryParameter("include_released", "include resources released at vendor").DataType("boolean")).
+		Param(ws.QueryParameter("ip", "resources own the ip").DataType("string")).
+		Param(ws.QueryParameter("cidr", "resources own ip in the cidr, e.g. 10.0.0.0/8").DataType("string")).
+		Param(ws.QueryParameter("ip_range", "resources own ip in the range, e.g. 10.0.0.1-10.0.0.100").DataType("string")).
+		Param(ws.QueryParameter("ip_type", "only match the ip type").DataType("string").PossibleValues([]string{"PRIVATE", "PUBLIC"})).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, only count resources visible to the caller").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Metadata("action", "list").
+		Writes(response.NewMessage(resource.AggregateResult{})).
+		Returns(200, "OK", resource.AggregateResult{}).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.GET("/lookup").To(h.LookupByIP).
+		Doc("lookup resources own the ip").
+		Param(ws.QueryParameter("ip", "ipv4 or ipv6 address").DataType("string").Required(true)).
+		Param(ws.QueryParameter("ip_type", "only match the ip type").DataType("string").PossibleValues([]string{"PRIVATE", "PUBLIC"})).
+		Param(ws.QueryParameter("include_released", "include resources released at vendor").DataType("boolean")).
+		Param(ws.QueryParameter("with_tags", "return resource tags").DataType("boolean")).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, only return resources visible to the caller").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Metadata("action", "list").
+		Writes(response.NewMessage(resource.ResourceSet{})).
+		Returns(200, "OK", resource.ResourceSet{}).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.GET("/expiring").To(h.ExpiringResources).
+		Doc("list prepaid resources expiring within days, order by expire_at").
+		Param(ws.QueryParameter("page_size", "page size").DataType("integer").DefaultValue("20")).
+		Param(ws.QueryParameter("page_number", "page number").DataType("integer").DefaultValue("1")).
+		Param(ws.QueryParameter("within_days", "expire within days").DataType("integer").DefaultValue("30")).
+		Param(ws.QueryParameter("pay_types", "prepaid pay types, separated by comma, ignore case").DataType("string")).
+		Param(ws.QueryParameter("namespace", "resource namespace").DataType("string")).
+		Param(ws.QueryParameter("env", "resource env").DataType("string")).
+		Param(ws.QueryParameter("vendor", "resource vendor").DataType("string").PossibleValues([]string{"ALIYUN", "TENCENT", "HUAWEI", "IDC"})).
+		Param(ws.QueryParameter("type", "resource type").DataType("string")).
+		Param(ws.QueryParameter("with_tags", "return resource tags").DataType("boolean")).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, only return resources visible to the caller").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Metadata("action", "list").
+		Writes(response.NewMessage(resource.ResourceSet{})).
+		Returns(200, "OK", resource.ResourceSet{}).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.GET("/{id}/tags").To(h.QueryTag).
+		Doc("get resource tags").
+		Param(ws.PathParameter("id", "identifier of the resource").DataType("string")).
+		Param(ws.QueryParameter("with_hidden", "return hidden tags").DataType("boolean")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(response.NewMessage(resource.TagSet{})).
+		Returns(200, "OK", resource.TagSet{}))
+
+	ws.Route(ws.POST("/{id}/tags").To(h.AddTag).
+		Doc("add or update resource tags, only USER tags can be modified").
+		Param(ws.PathParameter("id", "identifier of the resource").DataType("string")).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, caller identity").DataType("string")).
+		Param(ws.HeaderParameter(version.IfMatchHeader, "expected resource version, e.g. \"3\", fail with 412 if the resource has been modified").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(resource.UpdateTagRequest{}).
+		Writes(response.NewMessage(resource.Resource{})).
+		Returns(200, "OK", resource.Resource{}).
+		Returns(403, "Forbidden", nil).
+		Returns(404, "Not Found", nil).
+		Returns(412, "Precondition Failed", nil))
+
+	ws.Route(ws.DELETE("/{id}/tags").To(h.RemoveTag).
+		Doc("remove resource tags, only USER tags can be modified").
+		Param(ws.PathParameter("id", "identifier of the resource").DataType("string")).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, caller identity").DataType("string")).
+		Param(ws.HeaderParameter(version.IfMatchHeader, "expected resource version, e.g. \"3\", fail with 412 if the resource has been modified").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(resource.UpdateTagRequest{}).
+		Writes(response.NewMessage(resource.Resource{})).
+		Returns(200, "OK", resource.Resource{}).
+		Returns(403, "Forbidden", nil).
+		Returns(404, "Not Found", nil).
+		Returns(412, "Precondition Failed", nil))
+
+	ws.Route(ws.GET("/{id}/revisions").To(h.ListResourceRevisions).
+		Doc("list resource change history, latest first").
+		Param(ws.PathParameter("id", "identifier of the resource").DataType("string")).
+		Param(ws.QueryParameter("page_size", "page size").DataType("integer").DefaultValue("20")).
+		Param(ws.QueryParameter("page_number", "page number").DataType("integer").DefaultValue("1")).
+		Param(ws.QueryParameter("field", "only revisions changed this field, e.g. private_ip, tag.app, describe.cpu").DataType("string")).
+		Param(ws.QueryParameter("with_snapshot", "return before and after snapshot").DataType("boolean")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(response.NewMessage(resource.RevisionSet{})).
+		Returns(200, "OK", resource.RevisionSet{}))
+
+	ws.Route(ws.GET("/{id}/revisions/diff").To(h.DiffResourceRevisions).
+		Doc("diff the snapshots after two revisions of the resource").
+		Param(ws.PathParameter("id", "identifier of the resource").DataType("string")).
+		Param(ws.QueryParameter("a", "revision id").DataType("string").Required(true)).
+		Param(ws.QueryParameter("b", "revision id").DataType("string").Required(true)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(response.NewMessage(resource.RevisionDiff{})).
+		Returns(200, "OK", resource.RevisionDiff{}).
+		Returns(400, "Bad Request", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.PUT("/{id}/shared_policy").To(h.SetSharedPolicy).
+		Doc("set shared resource policy, only the owner namespace can set it").
+		Param(ws.PathParameter("id", "identifier of the resource").DataType("string")).
+		Param(ws.HeaderParameter(auth.AuthorizationHeader, "Bearer <token>, caller identity").DataType("string")).
+		Param(ws.HeaderParameter(version.IfMatchHeader, "expected resource version, e.g. \"3\", fail with 412 if the resource has been modified").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(resource.SharedPolicy{}).
+		Writes(response.NewMessage(resource.Resource{})).
+		Returns(200, "OK", resource.Resource{}).
+		Returns(400, "Bad Request", nil).
+		Returns(403, "Forbidden", nil).
+		Returns(404, "Not Found", nil).
+		Returns(412, "Precondition Failed", nil))
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
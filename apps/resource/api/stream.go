@@ -0,0 +1,110 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc"
+
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+const (
+	// NDJSONContentType 每行一个JSON对象
+	NDJSONContentType = "application/x-ndjson"
+	// StreamErrorTrailer 开始写入数据后发生错误时, 通过HTTP Trailer返回错误信息
+	StreamErrorTrailer = "X-Stream-Error"
+	// 每写入多少个资源刷新一次缓冲区
+	flushEvery = 100
+)
+
+// streamWriter 把流式查询的结果写入HTTP响应, 用于在HTTP接口中复用StreamSearch
+// 第一个资源写入时才发送响应头, 在此之前发生的错误仍然可以按普通的错误响应返回
+type streamWriter struct {
+	grpc.ServerStream
+	ctx     context.Context
+	w       http.ResponseWriter
+	header  http.Header
+	write   func(*resource.Resource) error
+	flush   func() error
+	started bool
+	written int64
+}
+
+func newStreamWriter(ctx context.Context, w http.ResponseWriter, contentType string) *streamWriter {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	return &streamWriter{ctx: ctx, w: w, header: header}
+}
+
+// newNDJSONWriter 每个资源序列化为一行JSON
+func newNDJSONWriter(ctx context.Context, w http.ResponseWriter) *streamWriter {
+	sw := newStreamWriter(ctx, w, NDJSONContentType)
+	buf := bufio.NewWriter(w)
+	enc := json.NewEncoder(buf)
+	sw.write = func(r *resource.Resource) error { return enc.Encode(r) }
+	sw.flush = buf.Flush
+	return sw
+}
+
+// newExportWriter 按导出格式逐行写入文件
+func newExportWriter(ctx context.Context, w http.ResponseWriter, ew resource.ExportWriter, format resource.ExportFormat, fileName string) *streamWriter {
+	sw := newStreamWriter(ctx, w, format.ContentType())
+	sw.header.Set("Content-Disposition", `attachment; filename="`+fileName+`"`)
+	sw.write = ew.Write
+	sw.flush = ew.Flush
+	return sw
+}
+
+func (s *streamWriter) Context() context.Context {
+	return s.ctx
+}
+
+// Start 发送响应头, 之后无法再修改状态码
+func (s *streamWriter) Start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	for k, v := range s.header {
+		s.w.Header()[k] = v
+	}
+	s.w.Header().Set("Trailer", StreamErrorTrailer)
+	s.w.WriteHeader(http.StatusOK)
+}
+
+// Started 是否已经开始写入响应
+func (s *streamWriter) Started() bool {
+	return s.started
+}
+
+func (s *streamWriter) Send(r *resource.Resource) error {
+	s.Start()
+	if err := s.write(r); err != nil {
+		return err
+	}
+	s.written++
+	if s.written%flushEvery == 0 {
+		return s.Flush()
+	}
+	return nil
+}
+
+// Flush 把缓冲区中的数据发送给客户端
+func (s *streamWriter) Flush() error {
+	if err := s.flush(); err != nil {
+		return err
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// Abort 已经写入的数据照常发送, 错误信息写入Trailer, 客户端据此判断数据是否完整
+func (s *streamWriter) Abort(err error) {
+	s.Flush()
+	s.w.Header().Set(StreamErrorTrailer, err.Error())
+}
@@ -1 +1,265 @@
 package api
+
+import (
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/common/version"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/response"
+)
+
+func (h *handler) SearchResource(r *restful.Request, w *restful.Response) {
+	// 加载查询条件
+	req, err := resource.NewSearchRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("SearchResource").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse search request error, %s", err))
+		return
+	}
+
+	// 数据查询
+	set, err := h.service.Search(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("SearchResource").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, set)
+}
+
+func (h *handler) LookupByIP(r *restful.Request, w *restful.Response) {
+	// 加载查询条件
+	req, err := resource.NewLookupByIPRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("LookupByIP").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse lookup by ip request error, %s", err))
+		return
+	}
+
+	set, err := h.service.LookupByIP(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("LookupByIP").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, set)
+}
+
+func (h *handler) ExpiringResources(r *restful.Request, w *restful.Response) {
+	// 加载查询条件
+	req, err := resource.NewExpiringResourcesRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("ExpiringResources").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse expiring resources request error, %s", err))
+		return
+	}
+
+	set, err := h.service.ExpiringResources(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("ExpiringResources").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, set)
+}
+
+func (h *handler) QueryTag(r *restful.Request, w *restful.Response) {
+	req := resource.NewQueryTagRequest(r.PathParameter("id"))
+	req.WithHidden = r.QueryParameter("with_hidden") == "true"
+
+	set, err := h.service.QueryTag(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("QueryTag").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, set)
+}
+
+func (h *handler) AddTag(r *restful.Request, w *restful.Response) {
+	h.updateTag(r, w, resource.UpdateAction_ADD)
+}
+
+func (h *handler) RemoveTag(r *restful.Request, w *restful.Response) {
+	h.updateTag(r, w, resource.UpdateAction_REMOVE)
+}
+
+func (h *handler) updateTag(r *restful.Request, w *restful.Response, action resource.UpdateAction) {
+	req := resource.NewUpdateTagRequest(r.PathParameter("id"), action)
+
+	if err := r.ReadEntity(req); err != nil {
+		h.log.Named("UpdateTag").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read tags error, %s", err))
+		return
+	}
+	// 资源id和操作以路径和方法为准
+	req.Id = r.PathParameter("id")
+	req.Action = action
+
+	// 传递了If-Match时以请求头为准
+	expected, err := version.ParseIfMatch(r.Request)
+	if err != nil {
+		h.log.Named("UpdateTag").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse version error, %s", err))
+		return
+	}
+	if expected > 0 {
+		req.ExpectedVersion = expected
+	}
+
+	ins, err := h.service.UpdateTag(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("UpdateTag").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	w.Header().Set(version.ETagHeader, version.NewETag(ins.Version))
+	response.Success(w.ResponseWriter, ins)
+}
+
+func (h *handler) SetSharedPolicy(r *restful.Request, w *restful.Response) {
+	req := resource.NewSetSharedPolicyRequest(r.PathParameter("id"))
+
+	if err := r.ReadEntity(req.SharedPolicy); err != nil {
+		h.log.Named("SetSharedPolicy").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read shared policy error, %s", err))
+		return
+	}
+	expected, err := version.ParseIfMatch(r.Request)
+	if err != nil {
+		h.log.Named("SetSharedPolicy").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse version error, %s", err))
+		return
+	}
+	req.ExpectedVersion = expected
+
+	ins, err := h.service.SetSharedPolicy(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("SetSharedPolicy").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	w.Header().Set(version.ETagHeader, version.NewETag(ins.Version))
+	response.Success(w.ResponseWriter, ins)
+}
+
+func (h *handler) ListResourceRevisions(r *restful.Request, w *restful.Response) {
+	req := resource.NewListResourceRevisionsRequestFromHTTP(r.Request, r.PathParameter("id"))
+
+	set, err := h.service.ListResourceRevisions(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("ListResourceRevisions").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, set)
+}
+
+func (h *handler) DiffResourceRevisions(r *restful.Request, w *restful.Response) {
+	req := resource.NewDiffResourceRevisionsRequest(r.QueryParameter("a"), r.QueryParameter("b"))
+
+	diff, err := h.service.DiffResourceRevisions(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("DiffResourceRevisions").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	// 变更需要属于路径中的资源
+	if diff.ResourceId != r.PathParameter("id") {
+		response.Failed(w.ResponseWriter, exception.NewNotFound("revision %s not found in resource %s", req.A, r.PathParameter("id")))
+		return
+	}
+
+	response.Success(w.ResponseWriter, diff)
+}
+
+func (h *handler) StreamSearchResource(r *restful.Request, w *restful.Response) {
+	// 加载查询条件
+	req, err := resource.NewSearchRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("StreamSearch").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse search request error, %s", err))
+		return
+	}
+	// 没有指定分页大小时使用服务端默认的批次大小
+	if r.QueryParameter("page_size") == "" {
+		req.Page = nil
+	}
+
+	stream := newNDJSONWriter(r.Request.Context(), w.ResponseWriter)
+	if err := h.service.StreamSearch(req, stream); err != nil {
+		h.log.Named("StreamSearch").Error(err)
+		// 已经开始写入数据时无法再修改状态码, 通过Trailer返回错误
+		if stream.Started() {
+			stream.Abort(err)
+		} else {
+			response.Failed(w.ResponseWriter, err)
+		}
+		return
+	}
+
+	// 没有任何资源时同样返回成功
+	stream.Start()
+	if err := stream.Flush(); err != nil {
+		h.log.Named("StreamSearch").Error(err)
+	}
+}
+
+func (h *handler) ExportResource(r *restful.Request, w *restful.Response) {
+	// 加载导出条件
+	req, err := resource.NewExportRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("ExportResource").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse export request error, %s", err))
+		return
+	}
+
+	ew := resource.NewExportWriter(w.ResponseWriter, req.Format, req.Columns)
+	stream := newExportWriter(r.Request.Context(), w.ResponseWriter, ew, req.Format, req.Format.FileName(time.Now()))
+	if err := h.service.StreamSearch(req.SearchRequest, stream); err != nil {
+		h.log.Named("ExportResource").Error(err)
+		// 已经开始写入数据时无法再修改状态码, 通过Trailer返回错误
+		if stream.Started() {
+			stream.Abort(err)
+		} else {
+			response.Failed(w.ResponseWriter, err)
+		}
+		return
+	}
+
+	// 没有任何资源时只导出列名
+	stream.Start()
+	if err := ew.Close(); err != nil {
+		h.log.Named("ExportResource").Error(err)
+		stream.Abort(err)
+	}
+}
+
+func (h *handler) Aggregate(r *restful.Request, w *restful.Response) {
+	// 加载统计条件
+	req, err := resource.NewAggregateRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("Aggregate").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse aggregate request error, %s", err))
+		return
+	}
+
+	set, err := h.service.Aggregate(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("Aggregate").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, set)
+}
@@ -1,5 +1,22 @@
 package resource
 
+import (
+	"context"
+	"database/sql"
+)
+
 const (
 	AppName = "resource"
 )
+
+// DescribeWriter 写入资源的特有属性(比如resource_host), 与资源的通用属性在同一个事务中执行
+type DescribeWriter func(ctx context.Context, tx *sql.Tx, resourceId string) error
+
+// Service 资源服务, 除了对外暴露的GRPC接口外, 还提供内部使用的接口
+type Service interface {
+	ServiceServer
+	// SaveWithDescribe 保存资源, 新增或者特有属性变化时在同一个事务中调用w写入特有属性, 仅供主机等内部模块使用
+	SaveWithDescribe(ctx context.Context, req *SaveRequest, w DescribeWriter) (*SaveResult, error)
+	// ReleaseResources 完整同步之后释放厂商侧已经删除的资源, 仅供同步任务使用, 不通过GRPC和HTTP暴露
+	ReleaseResources(ctx context.Context, req *ReleaseResourcesRequest) (*ReleaseResult, error)
+}
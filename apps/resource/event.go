@@ -0,0 +1,73 @@
+package resource
+
+import (
+	"time"
+)
+
+const (
+	// EventGapTimeout 版本号不连续时等待的时间
+	// 版本号在事务提交前分配, 后分配的事务可能先提交, 超过该时间仍然没有出现的版本号认为是回滚或者已经清理的事件
+	EventGapTimeout = 10 * time.Second
+)
+
+func NewWatchRequest(startRevision int64) *WatchRequest {
+	return &WatchRequest{
+		StartRevision: startRevision,
+	}
+}
+
+func (r *WatchRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+// Match 事件是否满足订阅条件
+func (r *WatchRequest) Match(e *Event) bool {
+	if r.ResourceType != nil && *r.ResourceType != e.ResourceType {
+		return false
+	}
+	if len(r.Types) == 0 {
+		return true
+	}
+	for _, t := range r.Types {
+		if t == e.Type {
+			return true
+		}
+	}
+	return false
+}
+
+func NewDefaultEvent() *Event {
+	return &Event{}
+}
+
+// EventCursor 按版本号顺序消费事件, 保证不会因为事务提交的顺序跳过事件
+type EventCursor struct {
+	// 已经消费的最后一个事件的版本号
+	Revision int64
+	// 版本号不连续时等待的时间
+	GapTimeout time.Duration
+}
+
+func NewEventCursor(revision int64) *EventCursor {
+	return &EventCursor{
+		Revision:   revision,
+		GapTimeout: EventGapTimeout,
+	}
+}
+
+// Advance 返回可以按顺序消费的事件, 事件需要按版本号升序排列
+// 遇到版本号不连续并且之后的事件还没有超过等待时间时停止, 等待缺失的事件提交后再继续
+func (c *EventCursor) Advance(events []*Event, now time.Time) []*Event {
+	ready := []*Event{}
+	for _, e := range events {
+		if e.Revision <= c.Revision {
+			continue
+		}
+		if e.Revision != c.Revision+1 && now.Sub(time.UnixMilli(e.CreateAt)) < c.GapTimeout {
+			break
+		}
+		c.Revision = e.Revision
+		ready = append(ready, e)
+	}
+	return ready
+}
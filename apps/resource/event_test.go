@@ -0,0 +1,72 @@
+package resource_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func newEvent(revision int64, createAt time.Time) *resource.Event {
+	e := resource.NewDefaultEvent()
+	e.Revision = revision
+	e.CreateAt = createAt.UnixMilli()
+	return e
+}
+
+func revisions(events []*resource.Event) []int64 {
+	items := []int64{}
+	for i := range events {
+		items = append(items, events[i].Revision)
+	}
+	return items
+}
+
+func TestEventCursorAdvance(t *testing.T) {
+	should := assert.New(t)
+
+	now := time.Now()
+	c := resource.NewEventCursor(10)
+
+	// 连续的事件直接消费, 已经消费过的事件忽略
+	ready := c.Advance([]*resource.Event{newEvent(10, now), newEvent(11, now), newEvent(12, now)}, now)
+	should.Equal([]int64{11, 12}, revisions(ready))
+	should.Equal(int64(12), c.Revision)
+
+	// 13还没有提交, 等待缺失的事件
+	ready = c.Advance([]*resource.Event{newEvent(14, now), newEvent(15, now)}, now)
+	should.Empty(ready)
+	should.Equal(int64(12), c.Revision)
+
+	// 13提交后按顺序消费
+	ready = c.Advance([]*resource.Event{newEvent(13, now), newEvent(14, now), newEvent(15, now)}, now)
+	should.Equal([]int64{13, 14, 15}, revisions(ready))
+
+	// 超过等待时间后跳过缺失的版本号
+	ready = c.Advance([]*resource.Event{newEvent(17, now), newEvent(19, now.Add(5*time.Second))}, now.Add(resource.EventGapTimeout))
+	should.Equal([]int64{17}, revisions(ready))
+	should.Equal(int64(17), c.Revision)
+}
+
+func TestWatchRequestMatch(t *testing.T) {
+	should := assert.New(t)
+
+	e := resource.NewDefaultEvent()
+	e.Type = resource.EventType_RESOURCE_TAG_CHANGED
+	e.ResourceType = resource.Type_RDS
+
+	req := resource.NewWatchRequest(0)
+	should.True(req.Match(e))
+
+	req.Types = []resource.EventType{resource.EventType_RESOURCE_CREATED, resource.EventType_RESOURCE_DELETED}
+	should.False(req.Match(e))
+	req.Types = append(req.Types, resource.EventType_RESOURCE_TAG_CHANGED)
+	should.True(req.Match(e))
+
+	host := resource.Type_HOST
+	req.ResourceType = &host
+	should.False(req.Match(e))
+
+	should.Error(resource.NewWatchRequest(-1).Validate())
+}
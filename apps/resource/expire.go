@@ -0,0 +1,109 @@
+package resource
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opengoats/goat/http/request"
+)
+
+const (
+	// 默认查询30天内过期的资源
+	DefaultExpiringWithinDays = 30
+)
+
+var (
+	// PrepaidPayTypes 各个厂商包年包月的付费方式, 比较时忽略大小写
+	PrepaidPayTypes = []string{"PrePaid", "Subscription", "包年包月", "Monthly", "Yearly"}
+)
+
+func (r *ExpiringResourcesRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewExpiringResourcesRequest() *ExpiringResourcesRequest {
+	return &ExpiringResourcesRequest{
+		Page:       request.NewDefaultPageRequest(),
+		WithinDays: DefaultExpiringWithinDays,
+		PayTypes:   PrepaidPayTypes,
+	}
+}
+
+// NewExpiringResourcesRequestFromHTTP 从HTTP请求的Query参数中加载查询条件
+func NewExpiringResourcesRequestFromHTTP(r *http.Request) (*ExpiringResourcesRequest, error) {
+	qs := r.URL.Query()
+
+	req := NewExpiringResourcesRequest()
+	req.Page = request.NewPageRequestFromHTTP(r)
+	req.Namespace = qs.Get("namespace")
+	req.Env = qs.Get("env")
+	req.WithTags = qs.Get("with_tags") == "true"
+
+	if days := qs.Get("within_days"); days != "" {
+		v, err := strconv.ParseInt(days, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		req.WithinDays = v
+	}
+	if pts := qs.Get("pay_types"); pts != "" {
+		req.PayTypes = strings.Split(pts, ",")
+	}
+	if vd := qs.Get("vendor"); vd != "" {
+		v, err := ParseVendorFromString(vd)
+		if err != nil {
+			return nil, err
+		}
+		req.Vendor = &v
+	}
+	if rt := qs.Get("type"); rt != "" {
+		v, err := ParseTypeFromString(rt)
+		if err != nil {
+			return nil, err
+		}
+		req.Type = &v
+	}
+
+	return req, nil
+}
+
+// SearchRequest 转换为通用的搜索条件, 复用搜索的过滤和权限校验
+func (r *ExpiringResourcesRequest) SearchRequest() *SearchRequest {
+	return &SearchRequest{
+		Page:      r.Page,
+		Namespace: r.Namespace,
+		Env:       r.Env,
+		Vendor:    r.Vendor,
+		Type:      r.Type,
+		WithTags:  r.WithTags,
+		Caller:    r.Caller,
+		Tags:      []*TagSelector{},
+	}
+}
+
+// ExpireRange 过期时间的查询范围(毫秒), 从当前时间开始, 已经过期的资源不返回
+func (r *ExpiringResourcesRequest) ExpireRange(now time.Time) (start, end int64) {
+	return now.UnixMilli(), now.Add(time.Duration(r.WithinDays) * 24 * time.Hour).UnixMilli()
+}
+
+// LowerPayTypes 小写的付费方式, 用于忽略大小写比较
+func (r *ExpiringResourcesRequest) LowerPayTypes() []string {
+	pts := make([]string, 0, len(r.PayTypes))
+	for i := range r.PayTypes {
+		if pt := strings.TrimSpace(r.PayTypes[i]); pt != "" {
+			pts = append(pts, strings.ToLower(pt))
+		}
+	}
+	return pts
+}
+
+// ExpireIn 距离过期的剩余时间
+func (r *Resource) ExpireIn(now time.Time) time.Duration {
+	return time.UnixMilli(r.ExpireAt).Sub(now)
+}
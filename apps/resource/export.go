@@ -0,0 +1,464 @@
+package resource
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportFormat 导出文件的格式
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatXLSX ExportFormat = "xlsx"
+)
+
+const (
+	// 以 tag:<key> 作为列名时, 导出该key的标签值, 同一个key有多个值时按值排序后以逗号分隔
+	ExportTagColumnPrefix = "tag:"
+	// 所有标签合并为一列, 格式为 key=value, 多个标签之间以逗号分隔
+	ExportTagsColumn = "tags"
+	// 导出文件中时间的格式
+	ExportTimeLayout = "2006-01-02 15:04:05"
+)
+
+var (
+	// ExportColumns 支持导出的列, 列名与json字段名保持一致
+	ExportColumns = []string{
+		"id", "status", "create_at", "create_by", "update_at", "update_by", "delete_at", "delete_by",
+		"c_id", "sync_at", "secret_id", "vendor", "resource_type", "region", "zone", "namespace", "env",
+		"usage_mode", "domain", "expire_at", "category", "type", "name", "description", "c_status",
+		"sync_account", "public_ip", "private_ip", "pay_type", ExportTagsColumn,
+	}
+	// DefaultExportColumns 没有指定列时默认导出的列
+	DefaultExportColumns = []string{
+		"id", "vendor", "resource_type", "region", "zone", "c_id", "name", "namespace", "env", "usage_mode",
+		"category", "type", "c_status", "public_ip", "private_ip", "pay_type", "expire_at", "sync_account",
+	}
+)
+
+// ParseExportFormatFromString 解析导出格式, 忽略大小写, 为空时默认为csv
+func ParseExportFormatFromString(str string) (ExportFormat, error) {
+	switch ExportFormat(strings.ToLower(strings.TrimSpace(str))) {
+	case "", ExportFormatCSV:
+		return ExportFormatCSV, nil
+	case ExportFormatXLSX:
+		return ExportFormatXLSX, nil
+	}
+	return "", fmt.Errorf("unknown export format: %s, support: %s, %s", str, ExportFormatCSV, ExportFormatXLSX)
+}
+
+// ContentType 导出文件的Content-Type
+func (f ExportFormat) ContentType() string {
+	if f == ExportFormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// FileName 导出文件的名称, 以导出时间区分
+func (f ExportFormat) FileName(t time.Time) string {
+	return fmt.Sprintf("resources-%s.%s", t.Format("20060102150405"), f)
+}
+
+// ExportRequest 导出资源, 过滤条件与搜索相同
+type ExportRequest struct {
+	*SearchRequest
+	// 导出格式
+	Format ExportFormat
+	// 导出的列
+	Columns []string
+}
+
+func (r *ExportRequest) Validate() error {
+	if err := r.SearchRequest.Validate(); err != nil {
+		return err
+	}
+	return ValidateExportColumns(r.Columns)
+}
+
+// HasTagColumn 是否需要导出标签
+func (r *ExportRequest) HasTagColumn() bool {
+	for _, c := range r.Columns {
+		if c == ExportTagsColumn || strings.HasPrefix(c, ExportTagColumnPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func NewExportRequest(format ExportFormat, columns ...string) *ExportRequest {
+	if len(columns) == 0 {
+		columns = DefaultExportColumns
+	}
+	req := &ExportRequest{
+		SearchRequest: NewSearchRequest(),
+		Format:        format,
+		Columns:       columns,
+	}
+	req.WithTags = req.HasTagColumn()
+	return req
+}
+
+// NewExportRequestFromHTTP 从HTTP请求的Query参数中加载导出条件
+// columns以逗号分隔, 比如: id,name,vendor,tag:app,tags
+func NewExportRequestFromHTTP(r *http.Request) (*ExportRequest, error) {
+	search, err := NewSearchRequestFromHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+	// 导出全部数据, 没有指定分页大小时使用服务端默认的批次大小
+	if r.URL.Query().Get("page_size") == "" {
+		search.Page = nil
+	}
+
+	format, err := ParseExportFormatFromString(r.URL.Query().Get("format"))
+	if err != nil {
+		return nil, err
+	}
+
+	req := &ExportRequest{
+		SearchRequest: search,
+		Format:        format,
+		Columns:       splitValues(r.URL.Query().Get("columns")),
+	}
+	if len(req.Columns) == 0 {
+		req.Columns = DefaultExportColumns
+	}
+	if err := ValidateExportColumns(req.Columns); err != nil {
+		return nil, err
+	}
+	// 只有导出标签时才需要查询标签
+	req.WithTags = req.WithTags || req.HasTagColumn()
+
+	return req, nil
+}
+
+// ValidateExportColumns 校验导出的列是否支持
+func ValidateExportColumns(columns []string) error {
+	if len(columns) == 0 {
+		return fmt.Errorf("export columns required")
+	}
+
+	for _, c := range columns {
+		if strings.HasPrefix(c, ExportTagColumnPrefix) {
+			if strings.TrimPrefix(c, ExportTagColumnPrefix) == "" {
+				return fmt.Errorf("export column %s tag key required", c)
+			}
+			continue
+		}
+		if !containsString(ExportColumns, c) {
+			return fmt.Errorf("unknown export column: %s, support: %s or %s<key>", c, ExportColumns, ExportTagColumnPrefix)
+		}
+	}
+	return nil
+}
+
+func containsString(items []string, s string) bool {
+	for i := range items {
+		if items[i] == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ExportValue 资源某一列导出的值, 枚举使用大写名称, 与json序列化保持一致
+func (r *Resource) ExportValue(column string) string {
+	if strings.HasPrefix(column, ExportTagColumnPrefix) {
+		return r.TagValues(strings.TrimPrefix(column, ExportTagColumnPrefix))
+	}
+
+	switch column {
+	case "id":
+		return r.Id
+	case "status":
+		return strconv.FormatInt(r.Status, 10)
+	case "create_at":
+		return formatExportTime(r.CreateAt)
+	case "create_by":
+		return r.CreateBy
+	case "update_at":
+		return formatExportTime(r.UpdateAt)
+	case "update_by":
+		return r.UpdateBy
+	case "delete_at":
+		return formatExportTime(r.DeleteAt)
+	case "delete_by":
+		return r.DeleteBy
+	case "c_id":
+		return r.Cid
+	case "sync_at":
+		return formatExportTime(r.SyncAt)
+	case "secret_id":
+		return r.SecretId
+	case "vendor":
+		return strings.ToUpper(r.Vendor.String())
+	case "resource_type":
+		return strings.ToUpper(r.ResourceType.String())
+	case "region":
+		return r.Region
+	case "zone":
+		return r.Zone
+	case "namespace":
+		return r.Namespace
+	case "env":
+		return r.Env
+	case "usage_mode":
+		return strings.ToUpper(r.UsageMode.String())
+	case "domain":
+		return r.Domain
+	case "expire_at":
+		return formatExportTime(r.ExpireAt)
+	case "category":
+		return r.Category
+	case "type":
+		return r.Type
+	case "name":
+		return r.Name
+	case "description":
+		return r.Description
+	case "c_status":
+		return r.CStatus
+	case "sync_account":
+		return r.SyncAccount
+	case "public_ip":
+		return r.PublicIPToString()
+	case "private_ip":
+		return r.PrivateIPToString()
+	case "pay_type":
+		return r.PayType
+	case ExportTagsColumn:
+		return r.TagsToString()
+	}
+	return ""
+}
+
+// TagValues 某个key的所有标签值, 按值排序后以逗号分隔
+func (r *Resource) TagValues(key string) string {
+	values := []string{}
+	for i := range r.Tags {
+		if r.Tags[i].Key == key {
+			values = append(values, r.Tags[i].Value)
+		}
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+// TagsToString 所有标签按 key=value 排序后以逗号分隔
+func (r *Resource) TagsToString() string {
+	tags := make([]string, 0, len(r.Tags))
+	for i := range r.Tags {
+		tags = append(tags, r.Tags[i].UniqueKey())
+	}
+	sort.Strings(tags)
+	return strings.Join(tags, ",")
+}
+
+// 没有设置的时间导出为空
+func formatExportTime(ms int64) string {
+	if ms <= 0 {
+		return ""
+	}
+	return time.UnixMilli(ms).Format(ExportTimeLayout)
+}
+
+// ExportWriter 逐行写入导出文件, 第一行为列名, 不会在内存中缓存全部数据
+type ExportWriter interface {
+	// 写入一个资源
+	Write(*Resource) error
+	// 把已经写入的数据发送给底层的Writer
+	Flush() error
+	// 完成导出, 没有数据时也会写入列名
+	Close() error
+}
+
+// NewExportWriter 按导出格式创建ExportWriter
+func NewExportWriter(w io.Writer, format ExportFormat, columns []string) ExportWriter {
+	if format == ExportFormatXLSX {
+		return newXLSXWriter(w, columns)
+	}
+	return newCSVWriter(w, columns)
+}
+
+type rowWriter struct {
+	columns []string
+	started bool
+	row     []string
+	write   func([]string) error
+}
+
+// writeHeader 第一次写入时先写入列名
+func (w *rowWriter) writeHeader() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	return w.write(w.columns)
+}
+
+func (w *rowWriter) Write(r *Resource) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	for i, c := range w.columns {
+		w.row[i] = r.ExportValue(c)
+	}
+	return w.write(w.row)
+}
+
+type csvWriter struct {
+	rowWriter
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer, columns []string) *csvWriter {
+	cw := &csvWriter{w: csv.NewWriter(w)}
+	cw.rowWriter = rowWriter{
+		columns: columns,
+		row:     make([]string, len(columns)),
+		write:   cw.w.Write,
+	}
+	// 使用UTF-8 BOM开头, 保证Excel打开时中文不乱码, 列名是第一行, 写入前缓冲区中没有数据
+	cw.rowWriter.write = func(row []string) error {
+		if _, err := io.WriteString(w, "\ufeff"); err != nil {
+			return err
+		}
+		cw.rowWriter.write = cw.w.Write
+		return cw.w.Write(row)
+	}
+	return cw
+}
+
+func (w *csvWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *csvWriter) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="resources" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+	xlsxSheetHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetFooter = `</sheetData></worksheet>`
+)
+
+// xlsxWriter 只有一个工作表的xlsx, 单元格使用内联字符串, 不需要共享字符串表, 可以边查询边写入
+type xlsxWriter struct {
+	rowWriter
+	zw    *zip.Writer
+	sheet *bufio.Writer
+}
+
+func newXLSXWriter(w io.Writer, columns []string) *xlsxWriter {
+	xw := &xlsxWriter{zw: zip.NewWriter(w)}
+	xw.rowWriter = rowWriter{
+		columns: columns,
+		row:     make([]string, len(columns)),
+		write:   xw.writeRow,
+	}
+	return xw
+}
+
+// open 写入工作簿的固定部分, 工作表作为最后一个文件持续写入
+func (w *xlsxWriter) open() error {
+	files := []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	}
+	for _, f := range files {
+		fw, err := w.zw.Create(f.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(fw, f.content); err != nil {
+			return err
+		}
+	}
+
+	sheet, err := w.zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	w.sheet = bufio.NewWriter(sheet)
+	_, err = w.sheet.WriteString(xlsxSheetHeader)
+	return err
+}
+
+func (w *xlsxWriter) writeRow(row []string) error {
+	if w.sheet == nil {
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+
+	w.sheet.WriteString("<row>")
+	for _, v := range row {
+		w.sheet.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+		if err := xml.EscapeText(w.sheet, []byte(v)); err != nil {
+			return err
+		}
+		w.sheet.WriteString("</t></is></c>")
+	}
+	_, err := w.sheet.WriteString("</row>")
+	return err
+}
+
+func (w *xlsxWriter) Flush() error {
+	if w.sheet == nil {
+		return nil
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zw.Flush()
+}
+
+func (w *xlsxWriter) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	if _, err := w.sheet.WriteString(xlsxSheetFooter); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zw.Close()
+}
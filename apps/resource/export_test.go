@@ -0,0 +1,119 @@
+package resource_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func newExportResource() *resource.Resource {
+	r := resource.NewDefaultResource()
+	r.Id = "r1"
+	r.Vendor = resource.Vendor_TENCENT
+	r.ResourceType = resource.Type_HOST
+	r.UsageMode = resource.UsageMode_MONOPOLY
+	r.Name = `web "01", prod`
+	r.ExpireAt = 1700000000000
+	r.PrivateIp = []string{"10.0.0.1", "10.0.0.2"}
+	r.Tags = []*resource.Tag{
+		{Key: "env", Value: "prod"},
+		{Key: "app", Value: "web"},
+		{Key: "app", Value: "api"},
+	}
+	return r
+}
+
+func TestExportValue(t *testing.T) {
+	should := assert.New(t)
+
+	r := newExportResource()
+	should.Equal("TENCENT", r.ExportValue("vendor"))
+	should.Equal("HOST", r.ExportValue("resource_type"))
+	should.Equal("MONOPOLY", r.ExportValue("usage_mode"))
+	should.Equal("10.0.0.1,10.0.0.2", r.ExportValue("private_ip"))
+	should.Equal(time.UnixMilli(1700000000000).Format(resource.ExportTimeLayout), r.ExportValue("expire_at"))
+	should.Equal("", r.ExportValue("sync_at"))
+	should.Equal("api,web", r.ExportValue("tag:app"))
+	should.Equal("", r.ExportValue("tag:owner"))
+	should.Equal("app=api,app=web,env=prod", r.ExportValue("tags"))
+}
+
+func TestNewExportRequestFromHTTP(t *testing.T) {
+	should := assert.New(t)
+
+	req, err := resource.NewExportRequestFromHTTP(httptest.NewRequest("GET", "/export?vendor=tencent", nil))
+	if should.NoError(err) {
+		should.Equal(resource.ExportFormatCSV, req.Format)
+		should.Equal(resource.DefaultExportColumns, req.Columns)
+		should.False(req.WithTags)
+		should.Nil(req.Page)
+		should.Equal(resource.Vendor_TENCENT, *req.Vendor)
+	}
+
+	req, err = resource.NewExportRequestFromHTTP(httptest.NewRequest("GET", "/export?format=XLSX&columns=id,name,tag:app", nil))
+	if should.NoError(err) {
+		should.Equal(resource.ExportFormatXLSX, req.Format)
+		should.Equal([]string{"id", "name", "tag:app"}, req.Columns)
+		should.True(req.WithTags)
+	}
+
+	_, err = resource.NewExportRequestFromHTTP(httptest.NewRequest("GET", "/export?format=pdf", nil))
+	should.Error(err)
+	_, err = resource.NewExportRequestFromHTTP(httptest.NewRequest("GET", "/export?columns=id,unknown", nil))
+	should.Error(err)
+	_, err = resource.NewExportRequestFromHTTP(httptest.NewRequest("GET", "/export?columns=id,tag:", nil))
+	should.Error(err)
+}
+
+func TestCSVExportWriter(t *testing.T) {
+	should := assert.New(t)
+
+	buf := bytes.NewBuffer(nil)
+	w := resource.NewExportWriter(buf, resource.ExportFormatCSV, []string{"id", "vendor", "name", "tag:app"})
+	should.NoError(w.Write(newExportResource()))
+	should.NoError(w.Close())
+	should.Equal("\ufeffid,vendor,name,tag:app\nr1,TENCENT,\"web \"\"01\"\", prod\",\"api,web\"\n", buf.String())
+
+	// 没有数据时只有列名
+	buf.Reset()
+	w = resource.NewExportWriter(buf, resource.ExportFormatCSV, []string{"id", "name"})
+	should.NoError(w.Close())
+	should.Equal("\ufeffid,name\n", buf.String())
+}
+
+func TestXLSXExportWriter(t *testing.T) {
+	should := assert.New(t)
+
+	buf := bytes.NewBuffer(nil)
+	w := resource.NewExportWriter(buf, resource.ExportFormatXLSX, []string{"id", "name", "tags"})
+	should.NoError(w.Write(newExportResource()))
+	should.NoError(w.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if !should.NoError(err) {
+		return
+	}
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if !should.NoError(err) {
+			return
+		}
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(b)
+	}
+	should.Contains(files, "[Content_Types].xml")
+	should.Contains(files, "xl/workbook.xml")
+	sheet := files["xl/worksheets/sheet1.xml"]
+	should.Equal(2, strings.Count(sheet, "<row>"))
+	should.Contains(sheet, `<t xml:space="preserve">web &#34;01&#34;, prod</t>`)
+	should.Contains(sheet, `<t xml:space="preserve">app=api,app=web,env=prod</t>`)
+}
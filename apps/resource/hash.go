@@ -0,0 +1,76 @@
+package resource
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// hashFields 参与resource_hash计算的通用属性, 系统字段(录入时间, 同步时间等)不参与计算
+type hashFields struct {
+	Vendor       Vendor    `json:"vendor"`
+	ResourceType Type      `json:"resource_type"`
+	Cid          string    `json:"c_id"`
+	SecretId     string    `json:"secret_id"`
+	Region       string    `json:"region"`
+	Zone         string    `json:"zone"`
+	Domain       string    `json:"domain"`
+	Namespace    string    `json:"namespace"`
+	Env          string    `json:"env"`
+	UsageMode    UsageMode `json:"usage_mode"`
+	ExpireAt     int64     `json:"expire_at"`
+	Category     string    `json:"category"`
+	Type         string    `json:"type"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	CStatus      string    `json:"c_status"`
+	SyncAccount  string    `json:"sync_account"`
+	PublicIp     []string  `json:"public_ip"`
+	PrivateIp    []string  `json:"private_ip"`
+	PayType      string    `json:"pay_type"`
+	Tags         []string  `json:"tags"`
+}
+
+// ComputeResourceHash 计算通用属性的Hash, 标签按key=value排序后参与计算
+func (r *Resource) ComputeResourceHash() string {
+	tags := make([]string, 0, len(r.Tags))
+	for i := range r.Tags {
+		tags = append(tags, r.Tags[i].UniqueKey())
+	}
+	sort.Strings(tags)
+
+	b, _ := json.Marshal(&hashFields{
+		Vendor:       r.Vendor,
+		ResourceType: r.ResourceType,
+		Cid:          r.Cid,
+		SecretId:     r.SecretId,
+		Region:       r.Region,
+		Zone:         r.Zone,
+		Domain:       r.Domain,
+		Namespace:    r.Namespace,
+		Env:          r.Env,
+		UsageMode:    r.UsageMode,
+		ExpireAt:     r.ExpireAt,
+		Category:     r.Category,
+		Type:         r.Type,
+		Name:         r.Name,
+		Description:  r.Description,
+		CStatus:      r.CStatus,
+		SyncAccount:  r.SyncAccount,
+		PublicIp:     r.PublicIp,
+		PrivateIp:    r.PrivateIp,
+		PayType:      r.PayType,
+		Tags:         tags,
+	})
+	return fmt.Sprintf("%x", sha1.Sum(b))
+}
+
+// ComputeDescribeHash 计算资源特有属性的Hash, encoding/json对map的key排序, 保证结果稳定
+func ComputeDescribeHash(describe map[string]string) string {
+	if describe == nil {
+		describe = map[string]string{}
+	}
+	b, _ := json.Marshal(describe)
+	return fmt.Sprintf("%x", sha1.Sum(b))
+}
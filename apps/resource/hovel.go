@@ -0,0 +1,386 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator"
+	"github.com/opengoats/goat/http/request"
+)
+
+var (
+	validate = validator.New()
+)
+
+func (r *SearchRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	// 校验标签选择器
+	for i := range r.Tags {
+		if err := r.Tags[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	// 校验IP过滤条件
+	if _, _, err := r.BuildIPFilters(); err != nil {
+		return err
+	}
+	if r.IpType != nil && !r.HasIPFilter() {
+		return fmt.Errorf("ip_type must be used with ip, cidr or ip_range")
+	}
+
+	return nil
+}
+
+func NewSearchRequest() *SearchRequest {
+	return &SearchRequest{
+		Page: request.NewDefaultPageRequest(),
+		Tags: []*TagSelector{},
+	}
+}
+
+// NewSearchRequestFromHTTP 从HTTP请求的Query参数中加载搜索条件
+func NewSearchRequestFromHTTP(r *http.Request) (*SearchRequest, error) {
+	qs := r.URL.Query()
+
+	req := &SearchRequest{
+		Page:            request.NewPageRequestFromHTTP(r),
+		Domain:          qs.Get("domain"),
+		Namespace:       qs.Get("namespace"),
+		Env:             qs.Get("env"),
+		SyncAccount:     qs.Get("sync_account"),
+		Status:          qs.Get("status"),
+		Keywords:        qs.Get("keywords"),
+		WithTags:        qs.Get("with_tags") == "true",
+		ExactMatch:      qs.Get("exact_match") == "true",
+		IncludeReleased: qs.Get("include_released") == "true",
+		Ip:              qs.Get("ip"),
+		Cidr:            qs.Get("cidr"),
+		IpRange:         qs.Get("ip_range"),
+		Tags:            []*TagSelector{},
+	}
+
+	if um := qs.Get("usage_mode"); um != "" {
+		v, err := ParseUsageModeFromString(um)
+		if err != nil {
+			return nil, err
+		}
+		req.UsageMode = &v
+	}
+	if vd := qs.Get("vendor"); vd != "" {
+		v, err := ParseVendorFromString(vd)
+		if err != nil {
+			return nil, err
+		}
+		req.Vendor = &v
+	}
+	if it := qs.Get("ip_type"); it != "" {
+		v, err := ParseIPTypeFromString(it)
+		if err != nil {
+			return nil, err
+		}
+		req.IpType = &v
+	}
+	if rt := qs.Get("type"); rt != "" {
+		v, err := ParseTypeFromString(rt)
+		if err != nil {
+			return nil, err
+		}
+		req.Type = &v
+	}
+
+	// 标签选择器, 允许传递多个tag参数
+	for _, tag := range qs["tag"] {
+		selectors, err := ParseTagSelectors(tag)
+		if err != nil {
+			return nil, err
+		}
+		req.Tags = append(req.Tags, selectors...)
+	}
+
+	return req, nil
+}
+
+// HasTag 是否有标签过滤条件
+func (r *SearchRequest) HasTag() bool {
+	return len(r.Tags) > 0
+}
+
+func NewResourceSet() *ResourceSet {
+	return &ResourceSet{
+		Items: []*Resource{},
+	}
+}
+
+func (s *ResourceSet) Add(item *Resource) {
+	s.Items = append(s.Items, item)
+}
+
+// ResourceIds 当前页所有资源的Id
+func (s *ResourceSet) ResourceIds() (ids []string) {
+	for i := range s.Items {
+		ids = append(ids, s.Items[i].Id)
+	}
+	return
+}
+
+// UpdateTag 按资源Id把标签回填到对应资源上
+func (s *ResourceSet) UpdateTag(tags *TagSet) {
+	rts := tags.ResourceTags()
+	for i := range s.Items {
+		s.Items[i].Tags = append(s.Items[i].Tags, rts[s.Items[i].Id]...)
+	}
+}
+
+func (r *Resource) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewDefaultResource() *Resource {
+	return &Resource{
+		SharedPolicy: &SharedPolicy{TagValues: []string{}},
+		Tags:         []*Tag{},
+		PublicIp:     []string{},
+		PrivateIp:    []string{},
+	}
+}
+
+func (r *Resource) AddTag(t *Tag) {
+	r.Tags = append(r.Tags, t)
+}
+
+// PublicIPToString 数据库中多个IP以逗号分隔存储
+func (r *Resource) PublicIPToString() string {
+	return strings.Join(r.PublicIp, ",")
+}
+
+func (r *Resource) PrivateIPToString() string {
+	return strings.Join(r.PrivateIp, ",")
+}
+
+func (r *Resource) LoadPublicIPString(s string) {
+	if s != "" {
+		r.PublicIp = strings.Split(s, ",")
+	}
+}
+
+func (r *Resource) LoadPrivateIPString(s string) {
+	if s != "" {
+		r.PrivateIp = strings.Split(s, ",")
+	}
+}
+
+func NewDefaultTag() *Tag {
+	return &Tag{
+		Weight: 1,
+		Meta:   map[string]string{},
+	}
+}
+
+func (t *Tag) Validate() error {
+	if err := validate.Struct(t); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+// MetaToString meta信息以json格式存储
+func (t *Tag) MetaToString() string {
+	if len(t.Meta) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(t.Meta)
+	return string(b)
+}
+
+func (t *Tag) LoadMetaString(s string) error {
+	if s == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(s), &t.Meta)
+}
+
+// Key 标签的唯一标识, 同一个资源下key value只允许有一对
+func (t *Tag) UniqueKey() string {
+	return t.Key + "=" + t.Value
+}
+
+// IsUserTag 只有用户自定义标签允许用户修改
+func (t *Tag) IsUserTag() bool {
+	return t.Type.Equal(TagType_USER)
+}
+
+func (r *UpdateTagRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewUpdateTagRequest(id string, action UpdateAction) *UpdateTagRequest {
+	return &UpdateTagRequest{
+		Id:     id,
+		Action: action,
+		Tags:   []*Tag{},
+	}
+}
+
+// CheckUserPermission 检查用户是否有权限修改这些标签
+// 第三方同步的标签和内部系统标签禁止通过本系统修改, 包括已经存在的同名标签
+func (r *UpdateTagRequest) CheckUserPermission(existTags []*Tag) error {
+	exist := make(map[string]*Tag, len(existTags))
+	for i := range existTags {
+		exist[existTags[i].UniqueKey()] = existTags[i]
+	}
+
+	for i := range r.Tags {
+		t := r.Tags[i]
+		if !t.IsUserTag() {
+			return fmt.Errorf("tag %s is %s tag, only USER tag can be modified", t.UniqueKey(), t.Type)
+		}
+		if e, ok := exist[t.UniqueKey()]; ok && !e.IsUserTag() {
+			return fmt.Errorf("tag %s is %s tag, only USER tag can be modified", e.UniqueKey(), e.Type)
+		}
+	}
+
+	return nil
+}
+
+func (r *QueryTagRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewQueryTagRequest(resourceIds ...string) *QueryTagRequest {
+	return &QueryTagRequest{
+		ResourceIds: resourceIds,
+	}
+}
+
+func NewTagSet() *TagSet {
+	return &TagSet{
+		Items: []*Tag{},
+	}
+}
+
+func (s *TagSet) Add(item *Tag) {
+	s.Items = append(s.Items, item)
+	s.Total++
+}
+
+// ResourceTags 按资源Id对标签分组
+func (s *TagSet) ResourceTags() map[string][]*Tag {
+	m := make(map[string][]*Tag, len(s.Items))
+	for i := range s.Items {
+		m[s.Items[i].ResourceId] = append(m[s.Items[i].ResourceId], s.Items[i])
+	}
+	return m
+}
+
+func (r *SaveRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewSaveRequest(ins *Resource) *SaveRequest {
+	return &SaveRequest{
+		Resource: ins,
+		Describe: map[string]string{},
+	}
+}
+
+// ComputeHash 计算资源的通用属性Hash和特有属性Hash
+func (r *SaveRequest) ComputeHash() {
+	r.Resource.ResourceHash = r.Resource.ComputeResourceHash()
+	r.Resource.DescribeHash = ComputeDescribeHash(r.Describe)
+}
+
+func (r *BatchSaveRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewBatchSaveRequest(items ...*SaveRequest) *BatchSaveRequest {
+	return &BatchSaveRequest{
+		Items: items,
+	}
+}
+
+func NewSaveResult(req *SaveRequest) *SaveResult {
+	r := &SaveResult{}
+	if req.Resource != nil {
+		r.Id = req.Resource.Id
+		r.Vendor = req.Resource.Vendor
+		r.Cid = req.Resource.Cid
+	}
+	return r
+}
+
+func (r *SaveResult) Failed(format string, a ...interface{}) {
+	r.Status = SaveStatus_FAILED
+	r.Message = fmt.Sprintf(format, a...)
+}
+
+func NewSaveResultSet() *SaveResultSet {
+	return &SaveResultSet{
+		Items: []*SaveResult{},
+	}
+}
+
+func (s *SaveResultSet) Add(item *SaveResult) {
+	s.Items = append(s.Items, item)
+	s.Total++
+}
+
+// Count 统计某种保存结果的数量
+func (s *SaveResultSet) Count(status SaveStatus) (n int64) {
+	for i := range s.Items {
+		if s.Items[i].Status.Equal(status) {
+			n++
+		}
+	}
+	return
+}
+
+func (r *ReleaseResourcesRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewReleaseResourcesRequest(secretId, region string, t Type, syncBefore int64) *ReleaseResourcesRequest {
+	return &ReleaseResourcesRequest{
+		SecretId:     secretId,
+		Region:       region,
+		ResourceType: t,
+		SyncBefore:   syncBefore,
+	}
+}
+
+func NewReleaseResult() *ReleaseResult {
+	return &ReleaseResult{
+		ResourceIds: []string{},
+	}
+}
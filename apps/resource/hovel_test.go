@@ -0,0 +1,75 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckUserPermission(t *testing.T) {
+	should := assert.New(t)
+
+	exist := []*resource.Tag{
+		{Key: "app", Value: "payments", Type: resource.TagType_SYSTEM},
+		{Key: "owner", Value: "ops", Type: resource.TagType_USER},
+		{Key: "acs:env", Value: "prod", Type: resource.TagType_THIRD},
+	}
+
+	req := resource.NewUpdateTagRequest("r1", resource.UpdateAction_ADD)
+	req.Tags = append(req.Tags, &resource.Tag{Key: "owner", Value: "ops", Weight: 2})
+	should.NoError(req.CheckUserPermission(exist))
+
+	// 请求中声明为非USER类型的标签
+	req.Tags = []*resource.Tag{{Key: "team", Value: "a", Type: resource.TagType_THIRD}}
+	should.Error(req.CheckUserPermission(exist))
+
+	// 覆盖已经存在的SYSTEM/THIRD标签
+	req.Tags = []*resource.Tag{{Key: "app", Value: "payments"}}
+	should.Error(req.CheckUserPermission(exist))
+	req.Action = resource.UpdateAction_REMOVE
+	req.Tags = []*resource.Tag{{Key: "acs:env", Value: "prod"}}
+	should.Error(req.CheckUserPermission(exist))
+}
+
+func TestUpdateTagRequestValidate(t *testing.T) {
+	should := assert.New(t)
+
+	req := resource.NewUpdateTagRequest("r1", resource.UpdateAction_ADD)
+	req.Tags = append(req.Tags, &resource.Tag{Key: "owner", Value: "ops", Weight: -1})
+	should.Error(req.Validate())
+
+	req.Tags[0].Weight = 3
+	should.NoError(req.Validate())
+
+	req.Tags[0].Value = ""
+	should.Error(req.Validate())
+}
+
+func TestComputeResourceHash(t *testing.T) {
+	should := assert.New(t)
+
+	a := resource.NewDefaultResource()
+	a.Cid = "i-001"
+	a.Name = "web-01"
+	a.Tags = []*resource.Tag{{Key: "app", Value: "payments"}, {Key: "env", Value: "prod"}}
+
+	b := resource.NewDefaultResource()
+	b.Cid = "i-001"
+	b.Name = "web-01"
+	b.Tags = []*resource.Tag{{Key: "env", Value: "prod"}, {Key: "app", Value: "payments"}}
+	// 系统字段不参与计算
+	b.SyncAt = 1000
+	b.Id = "xxx"
+
+	should.Equal(a.ComputeResourceHash(), b.ComputeResourceHash())
+
+	b.PrivateIp = []string{"10.2.3.4"}
+	should.NotEqual(a.ComputeResourceHash(), b.ComputeResourceHash())
+
+	should.Equal(
+		resource.ComputeDescribeHash(map[string]string{"cpu": "8", "memory": "16384"}),
+		resource.ComputeDescribeHash(map[string]string{"memory": "16384", "cpu": "8"}),
+	)
+	should.Equal(resource.ComputeDescribeHash(nil), resource.ComputeDescribeHash(map[string]string{}))
+}
@@ -0,0 +1,57 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/exception"
+)
+
+// aggregate 按分组维度统计资源数量, 以及主机的CPU和内存
+func (s *service) aggregate(ctx context.Context, req *resource.AggregateRequest) (*resource.AggregateResult, error) {
+	querySQL, args, err := resource.NewAggregateQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Named("Aggregate").Debugf("sql: %s; %v", querySQL, args)
+
+	queryStmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("Aggregate").Error(err)
+		return nil, exception.NewInternalServerError("aggregate resource err %s", err)
+	}
+	defer queryStmt.Close()
+
+	rows, err := queryStmt.QueryContext(ctx, args...)
+	if err != nil {
+		s.log.Named("Aggregate").Error(err)
+		return nil, exception.NewInternalServerError("aggregate resource err %s", err)
+	}
+	defer rows.Close()
+
+	set := resource.NewAggregateResult(req.GroupBy)
+	values := make([]string, len(req.GroupBy))
+	for rows.Next() {
+		// 分组维度之后依次为数量, CPU和内存
+		var count, cpu, memory int64
+		dest := make([]interface{}, 0, len(values)+3)
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		dest = append(dest, &count, &cpu, &memory)
+		if err := rows.Scan(dest...); err != nil {
+			s.log.Named("Aggregate").Error(err)
+			return nil, exception.NewInternalServerError("aggregate resource err %s", err)
+		}
+
+		item := resource.NewAggregateBucket(req.GroupBy, values)
+		item.Count, item.Cpu, item.Memory = count, cpu, memory
+		set.Add(item)
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("Aggregate").Error(err)
+		return nil, exception.NewInternalServerError("aggregate resource err %s", err)
+	}
+
+	return set, nil
+}
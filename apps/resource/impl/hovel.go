@@ -0,0 +1,412 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/common/version"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/request"
+	"github.com/opengoats/goat/sqlbuilder"
+)
+
+const (
+	// 批量查询时, 每次IN查询的数量, 避免参数过多
+	queryBatchSize = 500
+	// 通过IP反查资源时最多返回的资源数量, 同一个IP通常只属于少量资源
+	maxLookupResults = 100
+)
+
+func (s *service) search(ctx context.Context, req *resource.SearchRequest) (*resource.ResourceSet, error) {
+	query, join, err := resource.NewSearchQuery(sqlQueryResource, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.list(ctx, "Search", query.Order("r.sync_at").Desc(), join, req.Page, req.WithTags)
+}
+
+func (s *service) expiringResources(ctx context.Context, req *resource.ExpiringResourcesRequest) (*resource.ResourceSet, error) {
+	query, join, err := resource.NewSearchQuery(sqlQueryResource, req.SearchRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	// 指定时间内过期的包年包月资源
+	start, end := req.ExpireRange(time.Now())
+	query.Where("r.expire_at > ? AND r.expire_at <= ?", start, end)
+	if pts := req.LowerPayTypes(); len(pts) > 0 {
+		query.Where("LOWER(r.pay_type) IN (?"+strings.Repeat(",?", len(pts)-1)+")", resource.StringsToArgs(pts)...)
+	}
+
+	return s.list(ctx, "ExpiringResources", query.Order("r.expire_at").Asc(), join, req.Page, req.WithTags)
+}
+
+// list 按查询条件分页查询资源, 排序方式由调用方指定
+func (s *service) list(ctx context.Context, name string, query *sqlbuilder.Builder, join string, page *request.PageRequest, withTags bool) (*resource.ResourceSet, error) {
+	set := resource.NewResourceSet()
+
+	// 获取total, 需要在GroupBy之前构建, 否则COUNT会按分组统计
+	countSQL, args := query.BuildFromNewBase(fmt.Sprintf(sqlCountResource, join))
+	s.log.Named(name).Debugf("sql: %s; %v", countSQL, args)
+	countStmt, err := s.db.PrepareContext(ctx, countSQL)
+	if err != nil {
+		s.log.Named(name).Error(err)
+		return nil, exception.NewInternalServerError("count resource err %s", err)
+	}
+	defer countStmt.Close()
+
+	err = countStmt.QueryRowContext(ctx, args...).Scan(&set.Total)
+	if err != nil {
+		s.log.Named(name).Error(err)
+		return nil, exception.NewInternalServerError("count resource err %s", err)
+	}
+
+	// 获取分页数据, 一个资源对应多个标签, 需要按资源Id分组
+	querySQL, args := query.GroupBy("r.id").
+		Limit(page.ComputeOffset(), uint(page.PageSize)).Build()
+	s.log.Named(name).Debugf("sql: %s; %v", querySQL, args)
+	queryStmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named(name).Error(err)
+		return nil, exception.NewInternalServerError("query resource err %s", err)
+	}
+	defer queryStmt.Close()
+
+	rows, err := queryStmt.QueryContext(ctx, args...)
+	if err != nil {
+		s.log.Named(name).Error(err)
+		return nil, exception.NewInternalServerError("query resource err %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ins, err := scanResource(rows)
+		if err != nil {
+			s.log.Named(name).Error(err)
+			return nil, exception.NewInternalServerError("query resource err %s", err)
+		}
+		set.Add(ins)
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named(name).Error(err)
+		return nil, exception.NewInternalServerError("query resource err %s", err)
+	}
+
+	// 按需补充资源标签
+	if withTags && len(set.Items) > 0 {
+		tags, err := s.queryTag(ctx, resource.NewQueryTagRequest(set.ResourceIds()...))
+		if err != nil {
+			return nil, err
+		}
+		set.UpdateTag(tags)
+	}
+
+	return set, nil
+}
+
+func (s *service) queryTag(ctx context.Context, req *resource.QueryTagRequest) (*resource.TagSet, error) {
+	return s.queryTagIn(ctx, s.db, req)
+}
+
+// preparer 数据库连接或者事务, 在事务中查询可以读到事务内还没有提交的修改
+type preparer interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// queryTagIn 在指定的数据库连接或者事务中查询标签
+func (s *service) queryTagIn(ctx context.Context, p preparer, req *resource.QueryTagRequest) (*resource.TagSet, error) {
+	set := resource.NewTagSet()
+
+	// 按批次查询, 避免IN的参数过多, 查询次数只和资源数量/批次大小有关
+	for start := 0; start < len(req.ResourceIds); start += queryBatchSize {
+		end := start + queryBatchSize
+		if end > len(req.ResourceIds) {
+			end = len(req.ResourceIds)
+		}
+		if err := s.queryTagBatch(ctx, p, req.ResourceIds[start:end], req.WithHidden, set); err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
+
+func (s *service) queryTagBatch(ctx context.Context, p preparer, resourceIds []string, withHidden bool, set *resource.TagSet) error {
+	query := sqlbuilder.NewQuery(sqlQueryResourceTag)
+	query.Where("resource_id IN (?"+strings.Repeat(",?", len(resourceIds)-1)+")", resource.StringsToArgs(resourceIds)...)
+	if !withHidden {
+		query.Where("hidden = 0")
+	}
+
+	querySQL, args := query.Order("resource_id").Asc().Build()
+	s.log.Named("QueryTag").Debugf("sql: %s; %v", querySQL, args)
+	stmt, err := p.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("QueryTag").Error(err)
+		return exception.NewInternalServerError("query resource tag err %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		s.log.Named("QueryTag").Error(err)
+		return exception.NewInternalServerError("query resource tag err %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ins := resource.NewDefaultTag()
+		var meta string
+		err := rows.Scan(&ins.Key, &ins.Value, &ins.Describe, &ins.ResourceId, &ins.Weight, &ins.IsCost, &ins.Type, &ins.Hidden, &meta)
+		if err != nil {
+			s.log.Named("QueryTag").Error(err)
+			return exception.NewInternalServerError("query resource tag err %s", err)
+		}
+		if err := ins.LoadMetaString(meta); err != nil {
+			s.log.Named("QueryTag").Error(err)
+			return exception.NewInternalServerError("load resource tag meta err %s", err)
+		}
+		set.Add(ins)
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("QueryTag").Error(err)
+		return exception.NewInternalServerError("query resource tag err %s", err)
+	}
+
+	return nil
+}
+
+// visibleResourceIds 过滤出调用方有权访问的资源Id, 保持原有的顺序, 调用方为空(服务内部调用)时不过滤
+func (s *service) visibleResourceIds(ctx context.Context, ids []string, caller *resource.Caller) ([]string, error) {
+	if caller == nil || len(ids) == 0 {
+		return ids, nil
+	}
+
+	visible := map[string]bool{}
+	cond, condArgs := caller.BuildSQL()
+	for start := 0; start < len(ids); start += queryBatchSize {
+		end := start + queryBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		query := sqlbuilder.NewQuery(sqlQueryVisibleResourceId)
+		query.Where("r.id IN (?"+strings.Repeat(",?", end-start-1)+")", resource.StringsToArgs(ids[start:end])...)
+		query.Where(cond, condArgs...)
+		querySQL, args := query.Build()
+		s.log.Named("VisibleResource").Debugf("sql: %s; %v", querySQL, args)
+		rows, err := s.db.QueryContext(ctx, querySQL, args...)
+		if err != nil {
+			s.log.Named("VisibleResource").Error(err)
+			return nil, exception.NewInternalServerError("query visible resource err %s", err)
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				s.log.Named("VisibleResource").Error(err)
+				return nil, exception.NewInternalServerError("query visible resource err %s", err)
+			}
+			visible[id] = true
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			s.log.Named("VisibleResource").Error(err)
+			return nil, exception.NewInternalServerError("query visible resource err %s", err)
+		}
+	}
+
+	result := make([]string, 0, len(visible))
+	for _, id := range ids {
+		if visible[id] {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+// 字段顺序与sqlQueryResource保持一致
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanResource(row scanner) (*resource.Resource, error) {
+	ins := resource.NewDefaultResource()
+	var publicIP, privateIP, sharedTagValues string
+	err := row.Scan(
+		&ins.Id, &ins.Status, &ins.CreateAt, &ins.CreateBy, &ins.UpdateAt, &ins.UpdateBy, &ins.DeleteAt, &ins.DeleteBy,
+		&ins.Cid, &ins.ResourceType, &ins.Vendor, &ins.Region, &ins.Zone, &ins.ExpireAt, &ins.Category, &ins.Type,
+		&ins.Name, &ins.Description, &ins.CStatus, &ins.SyncAt, &ins.SyncAccount,
+		&publicIP, &privateIP, &ins.PayType, &ins.DescribeHash,
+		&ins.ResourceHash, &ins.SecretId, &ins.Domain, &ins.Namespace, &ins.Env, &ins.UsageMode,
+		&ins.SharedPolicy.TagKey, &sharedTagValues, &ins.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+	ins.LoadPublicIPString(publicIP)
+	ins.LoadPrivateIPString(privateIP)
+	ins.SharedPolicy.LoadTagValuesString(sharedTagValues)
+	return ins, nil
+}
+
+func (s *service) describe(ctx context.Context, id string) (*resource.Resource, error) {
+	return s.describeIn(ctx, s.db, id)
+}
+
+// describeIn 在指定的数据库连接或者事务中查询资源
+func (s *service) describeIn(ctx context.Context, p preparer, id string) (*resource.Resource, error) {
+	query := sqlbuilder.NewQuery(sqlQueryResource, "LEFT").Where("r.id = ?", id).GroupBy("r.id")
+
+	querySQL, args := query.Build()
+	s.log.Named("DescribeResource").Debugf("sql: %s; %v", querySQL, args)
+	stmt, err := p.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("DescribeResource").Error(err)
+		return nil, exception.NewInternalServerError("describe resource err %s", err)
+	}
+	defer stmt.Close()
+
+	ins, err := scanResource(stmt.QueryRowContext(ctx, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, exception.NewNotFound("resource %s not found", id)
+		}
+		s.log.Named("DescribeResource").Error(err)
+		return nil, exception.NewInternalServerError("describe resource err %s", err)
+	}
+
+	return ins, nil
+}
+
+func (s *service) updateTag(ctx context.Context, req *resource.UpdateTagRequest, ins *resource.Resource) (err error) {
+	// 开启一个事务
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return exception.NewInternalServerError("update resource tag err %s", err)
+	}
+
+	// 通过Defer处理事务提交方式
+	// 1. 无报错，则Commit 事务
+	// 2. 有报错，则Rollback 事务
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				s.log.Error("rollback error, %s", err.Error())
+			}
+		} else {
+			// 提交失败时通过返回值报告, 避免把没有写入的变更作为成功返回
+			if err = tx.Commit(); err != nil {
+				s.log.Error("commit error, %s", err.Error())
+				err = exception.NewInternalServerError("commit tx err %s", err)
+			}
+		}
+	}()
+
+	// 先使用读取时的版本号更新, 期间资源被其他请求修改时回滚
+	// 更新会锁定资源, 其他变更需要等待事务结束, 保证变更前的快照和本次变更之间没有其他变更
+	s.log.Named("UpdateTag").Debugf("sql: %s", sqlUpdateResourceVersion)
+	ret, err := tx.ExecContext(ctx, sqlUpdateResourceVersion, ins.Id, ins.Version)
+	if err != nil {
+		return exception.NewInternalServerError("update resource version err %s", err)
+	}
+	if n, _ := ret.RowsAffected(); n == 0 {
+		return version.NewConflict("resource %s has been modified, version %d is outdated", ins.Id, ins.Version)
+	}
+	ins.Version++
+
+	// 记录变更前的快照
+	before, err := s.previousSnapshotIn(ctx, tx, req.Id)
+	if err != nil {
+		return err
+	}
+
+	switch req.Action {
+	case resource.UpdateAction_ADD:
+		s.log.Named("UpdateTag").Debugf("sql: %s", sqlInsertOrUpdateResourceTag)
+		stmt, err := tx.PrepareContext(ctx, sqlInsertOrUpdateResourceTag)
+		if err != nil {
+			return exception.NewInternalServerError("update resource tag err %s", err)
+		}
+		defer stmt.Close()
+
+		// 变更人以认证后的调用方为准, 忽略请求中标签的create_by和update_by
+		now, actor := time.Now().UnixMilli(), req.Caller.Actor()
+		for i := range req.Tags {
+			t := req.Tags[i]
+			// 默认公平分摊, 权重为1
+			if t.Weight == 0 {
+				t.Weight = 1
+			}
+			meta := t.MetaToString()
+			_, err = stmt.ExecContext(ctx,
+				t.Type, t.Key, t.Value, t.Describe, req.Id, t.Weight, t.IsCost, t.Hidden, meta, 1, now, actor,
+				t.Describe, t.Weight, t.IsCost, t.Hidden, meta, now, actor,
+			)
+			if err != nil {
+				return exception.NewInternalServerError("add resource tag err %s", err)
+			}
+		}
+	case resource.UpdateAction_REMOVE:
+		s.log.Named("UpdateTag").Debugf("sql: %s", sqlDeleteResourceTag)
+		stmt, err := tx.PrepareContext(ctx, sqlDeleteResourceTag)
+		if err != nil {
+			return exception.NewInternalServerError("update resource tag err %s", err)
+		}
+		defer stmt.Close()
+
+		for i := range req.Tags {
+			_, err = stmt.ExecContext(ctx, req.Id, req.Tags[i].Key, req.Tags[i].Value)
+			if err != nil {
+				return exception.NewInternalServerError("remove resource tag err %s", err)
+			}
+		}
+	default:
+		return exception.NewBadRequest("unknown update action %s", req.Action)
+	}
+
+	// 在事务中查询变更后的标签, 与变更前的快照一起记录变更历史
+	tags, err := s.queryTagIn(ctx, tx, &resource.QueryTagRequest{ResourceIds: []string{req.Id}, WithHidden: true})
+	if err != nil {
+		return err
+	}
+	after := before.Clone()
+	after.SetTags(tags.Items)
+	if err = s.saveRevision(ctx, tx, req.Id, req.Caller.Actor(), resource.RevisionAction_UPDATE_TAG, before, after); err != nil {
+		return err
+	}
+
+	return s.appendEvent(ctx, tx, resource.EventType_RESOURCE_TAG_CHANGED, req.Id, req.Caller.Actor())
+}
+
+func (s *service) setSharedPolicy(ctx context.Context, ins *resource.Resource, policy *resource.SharedPolicy) error {
+	s.log.Named("SetSharedPolicy").Debugf("sql: %s", sqlUpdateSharedPolicy)
+	stmt, err := s.db.PrepareContext(ctx, sqlUpdateSharedPolicy)
+	if err != nil {
+		s.log.Named("SetSharedPolicy").Error(err)
+		return exception.NewInternalServerError("update resource shared policy err %s", err)
+	}
+	defer stmt.Close()
+
+	// 使用读取时的版本号更新, 期间资源被其他请求修改时更新失败
+	now := time.Now().UnixMilli()
+	ret, err := stmt.ExecContext(ctx, policy.TagKey, policy.TagValuesToString(), now, ins.Id, ins.Version)
+	if err != nil {
+		s.log.Named("SetSharedPolicy").Error(err)
+		return exception.NewInternalServerError("update resource shared policy err %s", err)
+	}
+	if n, _ := ret.RowsAffected(); n == 0 {
+		return version.NewConflict("resource %s has been modified, version %d is outdated", ins.Id, ins.Version)
+	}
+
+	ins.SharedPolicy = policy
+	ins.UpdateAt = now
+	ins.Version++
+	return nil
+}
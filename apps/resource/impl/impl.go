@@ -15,6 +15,8 @@ import (
 var (
 	// Service 服务实例
 	svr = &service{}
+	// 主机等内部模块通过resource.Service使用内部接口
+	_ resource.Service = svr
 )
 
 type service struct {
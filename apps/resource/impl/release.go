@@ -0,0 +1,107 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/exception"
+)
+
+// release 释放范围内本次同步没有同步到的资源, 资源只标记为已释放, 由清理命令按保留时间删除
+func (s *service) release(ctx context.Context, req *resource.ReleaseResourcesRequest) (*resource.ReleaseResult, error) {
+	result := resource.NewReleaseResult()
+
+	s.log.Named("ReleaseResources").Debugf("sql: %s", sqlQueryReleaseResource)
+	rows, err := s.db.QueryContext(ctx, sqlQueryReleaseResource, req.SecretId, req.Region, req.ResourceType, req.SyncBefore)
+	if err != nil {
+		s.log.Named("ReleaseResources").Error(err)
+		return nil, exception.NewInternalServerError("query release resource err %s", err)
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			s.log.Named("ReleaseResources").Error(err)
+			return nil, exception.NewInternalServerError("query release resource err %s", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("ReleaseResources").Error(err)
+		return nil, exception.NewInternalServerError("query release resource err %s", err)
+	}
+
+	now := time.Now().UnixMilli()
+	for start := 0; start < len(ids); start += queryBatchSize {
+		end := start + queryBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		batch := ids[start:end]
+		n, err := s.releaseBatch(ctx, req, batch, now)
+		if err != nil {
+			s.log.Named("ReleaseResources").Error(err)
+			return nil, err
+		}
+		result.Total += n
+		result.ResourceIds = append(result.ResourceIds, batch...)
+	}
+
+	return result, nil
+}
+
+// releaseBatch 释放一个批次的资源, 并在同一个事务中写入释放事件
+func (s *service) releaseBatch(ctx context.Context, req *resource.ReleaseResourcesRequest, batch []string, now int64) (n int64, err error) {
+	// 开启一个事务
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, exception.NewInternalServerError("release resource err %s", err)
+	}
+
+	// 通过Defer处理事务提交方式
+	// 1. 无报错，则Commit 事务
+	// 2. 有报错，则Rollback 事务
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				s.log.Error("rollback error, %s", err.Error())
+			}
+		} else {
+			// 提交失败时通过返回值报告, 避免把没有写入的变更作为成功返回
+			if err = tx.Commit(); err != nil {
+				s.log.Error("commit error, %s", err.Error())
+				err = exception.NewInternalServerError("commit tx err %s", err)
+			}
+		}
+	}()
+
+	// 查询之后又被同步到的资源不释放
+	placeholders := strings.Repeat(",?", len(batch)-1)
+	releaseSQL := fmt.Sprintf(sqlReleaseResource, placeholders)
+	args := append([]interface{}{now, req.DeleteBy}, resource.StringsToArgs(batch)...)
+	args = append(args, req.SyncBefore)
+	s.log.Named("ReleaseResources").Debugf("sql: %s; %v", releaseSQL, args)
+	ret, err := tx.ExecContext(ctx, releaseSQL, args...)
+	if err != nil {
+		return 0, exception.NewInternalServerError("release resource err %s", err)
+	}
+	n, _ = ret.RowsAffected()
+	if n == 0 {
+		return 0, nil
+	}
+
+	eventSQL := fmt.Sprintf(sqlInsertReleaseEvent, placeholders)
+	args = append([]interface{}{resource.EventType_RESOURCE_DELETED, now, req.DeleteBy}, resource.StringsToArgs(batch)...)
+	args = append(args, now)
+	s.log.Named("ReleaseResources").Debugf("sql: %s; %v", eventSQL, args)
+	if _, err = tx.ExecContext(ctx, eventSQL, args...); err != nil {
+		return 0, exception.NewInternalServerError("insert resource event err %s", err)
+	}
+	return n, nil
+}
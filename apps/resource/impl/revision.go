@@ -0,0 +1,165 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/sqlbuilder"
+)
+
+// previousSnapshot 资源上一次变更后的快照
+func (s *service) previousSnapshot(ctx context.Context, resourceId string) (resource.Snapshot, error) {
+	return s.previousSnapshotIn(ctx, s.db, resourceId)
+}
+
+// querier 数据库连接或者事务
+type querier interface {
+	preparer
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// previousSnapshotIn 在指定的数据库连接或者事务中查询资源上一次变更后的快照
+// 没有变更历史时(比如变更历史上线之前录入的资源)使用当前的数据构建, 此时特有属性未知
+func (s *service) previousSnapshotIn(ctx context.Context, q querier, resourceId string) (resource.Snapshot, error) {
+	query := sqlbuilder.NewQuery(sqlQueryRevision).Where("resource_id = ?", resourceId)
+	// 同一毫秒内的变更按Id排序, xid按生成时间递增
+	querySQL, args := query.Order("create_at DESC,id").Desc().Limit(0, 1).Build()
+	s.log.Named("PreviousSnapshot").Debugf("sql: %s; %v", querySQL, args)
+
+	ins, err := scanRevision(q.QueryRowContext(ctx, querySQL, args...))
+	if err == nil {
+		return ins.After, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, exception.NewInternalServerError("query resource revision err %s", err)
+	}
+
+	r, err := s.describeIn(ctx, q, resourceId)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := s.queryTagIn(ctx, q, &resource.QueryTagRequest{ResourceIds: []string{resourceId}, WithHidden: true})
+	if err != nil {
+		return nil, err
+	}
+	r.Tags = tags.Items
+	return resource.NewSnapshot(r, nil), nil
+}
+
+// saveUpdateRevision 同步更新时在同一个事务中记录变更, 快照中的标签包含用户标签, 需要在事务中重新查询
+func (s *service) saveUpdateRevision(ctx context.Context, tx *sql.Tx, item *resource.SaveRequest, before resource.Snapshot) error {
+	tags, err := s.queryTagIn(ctx, tx, &resource.QueryTagRequest{ResourceIds: []string{item.Resource.Id}, WithHidden: true})
+	if err != nil {
+		return err
+	}
+
+	after := resource.NewSnapshot(item.Resource, item.Describe)
+	after.SetTags(tags.Items)
+	return s.saveRevision(ctx, tx, item.Resource.Id, item.Resource.UpdateBy, resource.RevisionAction_UPDATE, before, after)
+}
+
+// execer 数据库连接或者事务
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// saveRevision 记录资源的变更历史, 在资源变更的事务中写入时, 写入失败会回滚资源的变更
+func (s *service) saveRevision(ctx context.Context, e execer, resourceId, actor string, action resource.RevisionAction, before, after resource.Snapshot) error {
+	ins := resource.NewRevision(resourceId, actor, action, before, after)
+	if ins == nil {
+		return nil
+	}
+	ins.CreateAt = time.Now().UnixMilli()
+
+	s.log.Named("SaveRevision").Debugf("sql: %s", sqlInsertRevision)
+	_, err := e.ExecContext(ctx, sqlInsertRevision,
+		ins.Id, ins.ResourceId, ins.CreateAt, ins.Actor, ins.Action, ins.ChangedFieldsToString(),
+		resource.Snapshot(ins.Before).String(), resource.Snapshot(ins.After).String(),
+	)
+	if err != nil {
+		return exception.NewInternalServerError("save resource %s revision err %s", resourceId, err)
+	}
+	return nil
+}
+
+func (s *service) listRevisions(ctx context.Context, req *resource.ListResourceRevisionsRequest) (*resource.RevisionSet, error) {
+	query := sqlbuilder.NewQuery(sqlQueryRevision).Where("resource_id = ?", req.ResourceId)
+	if req.Field != "" {
+		query.Where("FIND_IN_SET(?, changed_fields)", req.Field)
+	}
+
+	set := resource.NewRevisionSet()
+
+	// 获取total
+	countSQL, args := query.BuildFromNewBase(sqlCountRevision)
+	s.log.Named("ListResourceRevisions").Debugf("sql: %s; %v", countSQL, args)
+	if err := s.db.QueryRowContext(ctx, countSQL, args...).Scan(&set.Total); err != nil {
+		s.log.Named("ListResourceRevisions").Error(err)
+		return nil, exception.NewInternalServerError("count resource revision err %s", err)
+	}
+
+	// 获取分页数据, 最近的变更在前
+	querySQL, args := query.Order("create_at DESC,id").Desc().
+		Limit(req.Page.ComputeOffset(), uint(req.Page.PageSize)).Build()
+	s.log.Named("ListResourceRevisions").Debugf("sql: %s; %v", querySQL, args)
+	rows, err := s.db.QueryContext(ctx, querySQL, args...)
+	if err != nil {
+		s.log.Named("ListResourceRevisions").Error(err)
+		return nil, exception.NewInternalServerError("query resource revision err %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ins, err := scanRevision(rows)
+		if err != nil {
+			s.log.Named("ListResourceRevisions").Error(err)
+			return nil, exception.NewInternalServerError("query resource revision err %s", err)
+		}
+		if !req.WithSnapshot {
+			ins.Before, ins.After = nil, nil
+		}
+		set.Add(ins)
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("ListResourceRevisions").Error(err)
+		return nil, exception.NewInternalServerError("query resource revision err %s", err)
+	}
+
+	return set, nil
+}
+
+func (s *service) describeRevision(ctx context.Context, id string) (*resource.Revision, error) {
+	querySQL, args := sqlbuilder.NewQuery(sqlQueryRevision).Where("id = ?", id).Build()
+	s.log.Named("DescribeRevision").Debugf("sql: %s; %v", querySQL, args)
+
+	ins, err := scanRevision(s.db.QueryRowContext(ctx, querySQL, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, exception.NewNotFound("resource revision %s not found", id)
+		}
+		s.log.Named("DescribeRevision").Error(err)
+		return nil, exception.NewInternalServerError("describe resource revision err %s", err)
+	}
+	return ins, nil
+}
+
+// 字段顺序与sqlQueryRevision保持一致
+func scanRevision(row scanner) (*resource.Revision, error) {
+	ins := resource.NewDefaultRevision()
+	var changedFields, before, after string
+	err := row.Scan(&ins.Id, &ins.ResourceId, &ins.CreateAt, &ins.Actor, &ins.Action, &changedFields, &before, &after)
+	if err != nil {
+		return nil, err
+	}
+	ins.LoadChangedFieldsString(changedFields)
+	if ins.Before, err = resource.LoadSnapshotString(before); err != nil {
+		return nil, err
+	}
+	if ins.After, err = resource.LoadSnapshotString(after); err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
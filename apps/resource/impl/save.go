@@ -0,0 +1,413 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/sqlbuilder"
+)
+
+// existResource 数据库中已经存在的资源, 用于比对Hash
+type existResource struct {
+	id           string
+	resourceHash string
+	describeHash string
+	status       int64
+}
+
+// batchSave w不为空时, 在保存资源的事务中写入特有属性
+func (s *service) batchSave(ctx context.Context, req *resource.BatchSaveRequest, w resource.DescribeWriter) (*resource.SaveResultSet, error) {
+	set := resource.NewSaveResultSet()
+
+	// 校验并计算Hash, 按厂商分组后批量查询已经存在的资源
+	cids := map[resource.Vendor][]string{}
+	for i := range req.Items {
+		item := req.Items[i]
+		result := resource.NewSaveResult(item)
+		set.Add(result)
+
+		if err := item.Validate(); err != nil {
+			result.Failed("validate resource error, %s", err)
+			continue
+		}
+		if err := item.Resource.Validate(); err != nil {
+			result.Failed("validate resource error, %s", err)
+			continue
+		}
+		item.ComputeHash()
+		cids[item.Resource.Vendor] = append(cids[item.Resource.Vendor], item.Resource.Cid)
+	}
+
+	exists := map[string]*existResource{}
+	for vendor, ids := range cids {
+		for start := 0; start < len(ids); start += queryBatchSize {
+			end := start + queryBatchSize
+			if end > len(ids) {
+				end = len(ids)
+			}
+			if err := s.queryResourceHash(ctx, vendor, ids[start:end], exists); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// 逐个保存, 单个资源失败不影响其他资源
+	unchanged := []string{}
+	for i := range req.Items {
+		item, result := req.Items[i], set.Items[i]
+		if result.Status.Equal(resource.SaveStatus_FAILED) {
+			continue
+		}
+
+		key := existKey(item.Resource.Vendor, item.Resource.Cid)
+		exist, ok := exists[key]
+		if item.DryRun {
+			dryRun(item, result, exist)
+			continue
+		}
+		if !ok {
+			item.Resource.Id = xid.New().String()
+			if err := s.insertResource(ctx, item, w); err != nil {
+				result.Failed("%s", err)
+				continue
+			}
+			result.Id = item.Resource.Id
+			result.Status = resource.SaveStatus_CREATED
+			result.ResourceHashChanged = true
+			result.DescribeHashChanged = true
+			item.Resource.ResourceHashChanged = true
+			item.Resource.DescribeHashChanged = true
+		} else {
+			item.Resource.Id = exist.id
+			result.Id = exist.id
+			result.ResourceHashChanged = exist.resourceHash != item.Resource.ResourceHash
+			result.DescribeHashChanged = exist.describeHash != item.Resource.DescribeHash
+			// 已经释放的资源重新同步到时, 即使没有变化也需要恢复
+			if !result.ResourceHashChanged && !result.DescribeHashChanged && exist.status > 0 {
+				result.Status = resource.SaveStatus_UNCHANGED
+				unchanged = append(unchanged, exist.id)
+				continue
+			}
+			item.Resource.ResourceHashChanged = result.ResourceHashChanged
+			item.Resource.DescribeHashChanged = result.DescribeHashChanged
+			before, err := s.previousSnapshot(ctx, exist.id)
+			if err != nil {
+				s.log.Named("SaveResource").Errorf("load resource %s previous snapshot error, %s", exist.id, err)
+			}
+			if err := s.updateResource(ctx, item, before, w); err != nil {
+				result.Failed("%s", err)
+				continue
+			}
+			result.Status = resource.SaveStatus_UPDATED
+		}
+
+		// 同一批次中重复的资源, 以最后一次保存的为准
+		exists[key] = &existResource{
+			id:           item.Resource.Id,
+			resourceHash: item.Resource.ResourceHash,
+			describeHash: item.Resource.DescribeHash,
+			status:       item.Resource.Status,
+		}
+	}
+
+	// 没有变化的资源批量更新同步时间, 失败时不能判断资源是否已经删除, 整批返回错误
+	for start := 0; start < len(unchanged); start += queryBatchSize {
+		end := start + queryBatchSize
+		if end > len(unchanged) {
+			end = len(unchanged)
+		}
+		if err := s.touchResource(ctx, unchanged[start:end]); err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
+
+// dryRun 只根据Hash比对结果判断保存结果, 不写入数据库
+func dryRun(item *resource.SaveRequest, result *resource.SaveResult, exist *existResource) {
+	if exist == nil {
+		result.Status = resource.SaveStatus_CREATED
+		result.ResourceHashChanged = true
+		result.DescribeHashChanged = true
+		return
+	}
+
+	result.Id = exist.id
+	result.ResourceHashChanged = exist.resourceHash != item.Resource.ResourceHash
+	result.DescribeHashChanged = exist.describeHash != item.Resource.DescribeHash
+	if !result.ResourceHashChanged && !result.DescribeHashChanged && exist.status > 0 {
+		result.Status = resource.SaveStatus_UNCHANGED
+	} else {
+		result.Status = resource.SaveStatus_UPDATED
+	}
+}
+
+func (s *service) touchResource(ctx context.Context, ids []string) error {
+	touchSQL := fmt.Sprintf(sqlTouchResource, strings.Repeat(",?", len(ids)-1))
+	args := append([]interface{}{time.Now().UnixMilli()}, resource.StringsToArgs(ids)...)
+	s.log.Named("SaveResource").Debugf("sql: %s; %v", touchSQL, args)
+	if _, err := s.db.ExecContext(ctx, touchSQL, args...); err != nil {
+		s.log.Named("SaveResource").Error(err)
+		return exception.NewInternalServerError("touch resource sync_at err %s", err)
+	}
+	return nil
+}
+
+func existKey(vendor resource.Vendor, cid string) string {
+	return vendor.String() + "/" + cid
+}
+
+func (s *service) queryResourceHash(ctx context.Context, vendor resource.Vendor, cids []string, exists map[string]*existResource) error {
+	query := sqlbuilder.NewQuery(sqlQueryResourceHash)
+	query.Where("vendor = ?", vendor)
+	query.Where("c_id IN (?"+strings.Repeat(",?", len(cids)-1)+")", resource.StringsToArgs(cids)...)
+
+	querySQL, args := query.Build()
+	s.log.Named("SaveResource").Debugf("sql: %s; %v", querySQL, args)
+	stmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("SaveResource").Error(err)
+		return exception.NewInternalServerError("query resource hash err %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		s.log.Named("SaveResource").Error(err)
+		return exception.NewInternalServerError("query resource hash err %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid string
+		ins := &existResource{}
+		if err := rows.Scan(&ins.id, &cid, &ins.resourceHash, &ins.describeHash, &ins.status); err != nil {
+			s.log.Named("SaveResource").Error(err)
+			return exception.NewInternalServerError("query resource hash err %s", err)
+		}
+		exists[existKey(vendor, cid)] = ins
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("SaveResource").Error(err)
+		return exception.NewInternalServerError("query resource hash err %s", err)
+	}
+
+	return nil
+}
+
+// insertResource 资源, 特有属性, IP, 标签, 变更历史和事件在一个事务中写入
+func (s *service) insertResource(ctx context.Context, item *resource.SaveRequest, w resource.DescribeWriter) (err error) {
+	ins := item.Resource
+
+	// 开启一个事务
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return exception.NewInternalServerError("insert resource err %s", err)
+	}
+
+	// 通过Defer处理事务提交方式
+	// 1. 无报错，则Commit 事务
+	// 2. 有报错，则Rollback 事务
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				s.log.Error("rollback error, %s", err.Error())
+			}
+		} else {
+			// 提交失败时通过返回值报告, 避免把没有写入的变更作为成功返回
+			if err = tx.Commit(); err != nil {
+				s.log.Error("commit error, %s", err.Error())
+				err = exception.NewInternalServerError("commit tx err %s", err)
+			}
+		}
+	}()
+
+	now := time.Now().UnixMilli()
+	ins.Status = 1
+	ins.CreateAt = now
+	ins.SyncAt = now
+	ins.Version = 1
+
+	s.log.Named("SaveResource").Debugf("sql: %s", sqlInsertResource)
+	stmt, err := tx.PrepareContext(ctx, sqlInsertResource)
+	if err != nil {
+		return exception.NewInternalServerError("insert resource err %s", err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		ins.Id, ins.ResourceType, ins.Vendor, ins.Region, ins.Zone, ins.CreateAt, ins.CreateBy, ins.ExpireAt, ins.Category, ins.Type,
+		ins.Name, ins.Description, ins.Cid, ins.CStatus, ins.Status, ins.UpdateAt, ins.SyncAt, ins.SyncAccount, ins.PublicIPToString(),
+		ins.PrivateIPToString(), ins.PayType, ins.DescribeHash, ins.ResourceHash, ins.SecretId, ins.Domain,
+		ins.Namespace, ins.Env, ins.UsageMode, ins.Version,
+	)
+	if err != nil {
+		return exception.NewInternalServerError("insert resource err %s", err)
+	}
+
+	if w != nil {
+		if err = w(ctx, tx, ins.Id); err != nil {
+			return err
+		}
+	}
+	if err = s.replaceResourceIP(ctx, tx, ins); err != nil {
+		return err
+	}
+	if err = s.replaceThirdTag(ctx, tx, ins); err != nil {
+		return err
+	}
+	err = s.saveRevision(ctx, tx, ins.Id, ins.CreateBy, resource.RevisionAction_CREATE, nil, resource.NewSnapshot(ins, item.Describe))
+	if err != nil {
+		return err
+	}
+	return s.appendEvent(ctx, tx, resource.EventType_RESOURCE_CREATED, ins.Id, ins.CreateBy)
+}
+
+// updateResource 没有变更前的快照时(查询失败)不记录变更历史
+func (s *service) updateResource(ctx context.Context, item *resource.SaveRequest, before resource.Snapshot, w resource.DescribeWriter) (err error) {
+	ins := item.Resource
+
+	// 开启一个事务
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return exception.NewInternalServerError("update resource err %s", err)
+	}
+
+	// 通过Defer处理事务提交方式
+	// 1. 无报错，则Commit 事务
+	// 2. 有报错，则Rollback 事务
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				s.log.Error("rollback error, %s", err.Error())
+			}
+		} else {
+			// 提交失败时通过返回值报告, 避免把没有写入的变更作为成功返回
+			if err = tx.Commit(); err != nil {
+				s.log.Error("commit error, %s", err.Error())
+				err = exception.NewInternalServerError("commit tx err %s", err)
+			}
+		}
+	}()
+
+	now := time.Now().UnixMilli()
+	ins.Status = 2
+	ins.UpdateAt = now
+	ins.SyncAt = now
+
+	s.log.Named("SaveResource").Debugf("sql: %s", sqlUpdateResource)
+	stmt, err := tx.PrepareContext(ctx, sqlUpdateResource)
+	if err != nil {
+		return exception.NewInternalServerError("update resource err %s", err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		ins.Region, ins.Zone, ins.ExpireAt, ins.Category, ins.Type, ins.Name, ins.Description, ins.CStatus,
+		ins.Status, ins.UpdateAt, ins.UpdateBy, ins.SyncAt, ins.SyncAccount,
+		ins.PublicIPToString(), ins.PrivateIPToString(), ins.PayType, ins.DescribeHash, ins.ResourceHash,
+		ins.SecretId, ins.Domain, ins.Namespace, ins.Env, ins.UsageMode,
+		ins.Id,
+	)
+	if err != nil {
+		return exception.NewInternalServerError("update resource err %s", err)
+	}
+
+	// 特有属性写入失败时回滚describe_hash, 下次同步时重新写入
+	if w != nil && ins.DescribeHashChanged {
+		if err = w(ctx, tx, ins.Id); err != nil {
+			return err
+		}
+	}
+
+	// IP和标签参与resource_hash计算, 只有通用属性变化时才需要同步
+	if ins.ResourceHashChanged {
+		if err = s.replaceResourceIP(ctx, tx, ins); err != nil {
+			return err
+		}
+		if err = s.replaceThirdTag(ctx, tx, ins); err != nil {
+			return err
+		}
+	}
+	if before != nil {
+		if err = s.saveUpdateRevision(ctx, tx, item, before); err != nil {
+			return err
+		}
+	}
+	return s.appendEvent(ctx, tx, resource.EventType_RESOURCE_UPDATED, ins.Id, ins.UpdateBy)
+}
+
+// replaceResourceIP 资源的IP整体替换, 不是IP的地址(比如域名)不会被索引
+func (s *service) replaceResourceIP(ctx context.Context, tx *sql.Tx, ins *resource.Resource) error {
+	s.log.Named("SaveResource").Debugf("sql: %s", sqlDeleteResourceIP)
+	if _, err := tx.ExecContext(ctx, sqlDeleteResourceIP, ins.Id); err != nil {
+		return exception.NewInternalServerError("delete resource ip err %s", err)
+	}
+
+	ips := ins.IPs()
+	if len(ips) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(ips))
+	args := make([]interface{}, 0, len(ips)*5)
+	for _, ip := range ips {
+		values = append(values, sqlInsertResourceIPValues)
+		args = append(args, ip.ResourceId, ip.Type, ip.Family, ip.Address, ip.Bytes())
+	}
+
+	insertSQL := sqlInsertResourceIP + strings.Join(values, ",")
+	s.log.Named("SaveResource").Debugf("sql: %s", insertSQL)
+	if _, err := tx.ExecContext(ctx, insertSQL, args...); err != nil {
+		return exception.NewInternalServerError("insert resource ip err %s", err)
+	}
+	return nil
+}
+
+// replaceThirdTag 同步过来的标签都是第三方标签, 整体替换
+func (s *service) replaceThirdTag(ctx context.Context, tx *sql.Tx, ins *resource.Resource) error {
+	s.log.Named("SaveResource").Debugf("sql: %s", sqlDeleteThirdResourceTag)
+	_, err := tx.ExecContext(ctx, sqlDeleteThirdResourceTag, ins.Id)
+	if err != nil {
+		return exception.NewInternalServerError("delete third resource tag err %s", err)
+	}
+
+	if len(ins.Tags) == 0 {
+		return nil
+	}
+
+	s.log.Named("SaveResource").Debugf("sql: %s", sqlInsertOrUpdateResourceTag)
+	stmt, err := tx.PrepareContext(ctx, sqlInsertOrUpdateResourceTag)
+	if err != nil {
+		return exception.NewInternalServerError("insert third resource tag err %s", err)
+	}
+	defer stmt.Close()
+
+	now := time.Now().UnixMilli()
+	for i := range ins.Tags {
+		t := ins.Tags[i]
+		t.ResourceId = ins.Id
+		t.Type = resource.TagType_THIRD
+		if t.Weight == 0 {
+			t.Weight = 1
+		}
+		meta := t.MetaToString()
+		_, err = stmt.ExecContext(ctx,
+			t.Type, t.Key, t.Value, t.Describe, t.ResourceId, t.Weight, t.IsCost, t.Hidden, meta, 1, now, ins.UpdateBy,
+			t.Describe, t.Weight, t.IsCost, t.Hidden, meta, now, ins.UpdateBy,
+		)
+		if err != nil {
+			return exception.NewInternalServerError("insert third resource tag err %s", err)
+		}
+	}
+
+	return nil
+}
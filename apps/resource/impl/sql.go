@@ -2,29 +2,58 @@ package impl
 
 const (
 	sqlInsertResource = `INSERT INTO resource (
-		id,resource_type,vendor,region,zone,create_at,expire_at,category,type,
-		name,description,status,update_at,sync_at,sync_accout,public_ip,
+		id,resource_type,vendor,region,zone,create_at,create_by,expire_at,category,type,
+		name,description,c_id,c_status,status,update_at,sync_at,sync_accout,public_ip,
 		private_ip,pay_type,describe_hash,resource_hash,secret_id,domain,
-		namespace,env,usage_mode
-	) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?);`
-	// 定义的用于变更Informtion属性
+		namespace,env,usage_mode,version
+	) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?);`
+	// 定义的用于变更Informtion属性, 已经释放的资源重新同步到时恢复
 	sqlUpdateResource = `UPDATE resource SET 
-		expire_at=?,category=?,type=?,name=?,description=?,
-		status=?,update_at=?,sync_at=?,sync_accout=?,
+		region=?,zone=?,expire_at=?,category=?,type=?,name=?,description=?,c_status=?,
+		status=?,update_at=?,update_by=?,delete_at=0,delete_by='',sync_at=?,sync_accout=?,
 		public_ip=?,private_ip=?,pay_type=?,describe_hash=?,resource_hash=?,
-		secret_id=?,namespace=?,env=?,usage_mode=?
+		secret_id=?,domain=?,namespace=?,env=?,usage_mode=?,version=version+1
 	WHERE id = ?`
-	sqlDeleteResource = `DELETE FROM resource WHERE id = ?;`
+	// 通过(vendor, c_id)查询已经存在的资源, 用于比对Hash
+	sqlQueryResourceHash = `SELECT id,c_id,resource_hash,describe_hash,status FROM resource`
+	// 没有变化的资源只更新同步时间, 用于判断资源是否已经在厂商侧删除
+	sqlTouchResource = `UPDATE resource SET sync_at = ? WHERE id IN (?%s)`
+	// 完整同步后, 同步时间早于本次同步的资源已经在厂商侧删除
+	sqlQueryReleaseResource = `SELECT id FROM resource 
+	WHERE secret_id = ? AND region = ? AND resource_type = ? AND status > 0 AND IFNULL(sync_at,0) < ?`
+	sqlReleaseResource = `UPDATE resource SET status = 0,delete_at = ?,delete_by = ?,version = version+1 
+	WHERE id IN (?%s) AND IFNULL(sync_at,0) < ?`
+	// 同步时使用第三方标签整体替换
+	sqlDeleteThirdResourceTag = `DELETE FROM resource_tag WHERE resource_id = ? AND type = 1;`
+	sqlDeleteResource         = `DELETE FROM resource WHERE id = ?;`
+	// 资源的IP随资源通用属性一起整体替换
+	sqlDeleteResourceIP       = `DELETE FROM resource_ip WHERE resource_id = ?;`
+	sqlInsertResourceIPValues = `(?,?,?,?,?)`
+	sqlInsertResourceIP       = `INSERT INTO resource_ip (resource_id,type,family,address,ip) VALUES `
+	// 共享策略由用户维护, 同步时不会覆盖
+	sqlUpdateSharedPolicy = `UPDATE resource SET shared_tag_key=?,shared_tag_values=?,update_at=?,version=version+1 
+	WHERE id = ? AND version = ?`
+	// 标签修改也是资源的一次变更, 使用读取时的版本号更新, 期间被其他请求修改时更新失败
+	sqlUpdateResourceVersion = `UPDATE resource SET version=version+1 WHERE id = ? AND version = ?`
 	// SELECT r.* FROM resource r LEFT JOIN resource_tag t ON r.id=t.resource_id WHERE t.t_key='xx', t.t_value='xxx';
-	sqlQueryResource = `SELECT r.* FROM resource r %s JOIN resource_tag t ON r.id = t.resource_id`
+	sqlQueryResource = `SELECT 
+		r.id,r.status,r.create_at,r.create_by,r.update_at,r.update_by,r.delete_at,r.delete_by,
+		r.c_id,r.resource_type,r.vendor,r.region,r.zone,IFNULL(r.expire_at,0),r.category,r.type,
+		r.name,IFNULL(r.description,''),r.c_status,IFNULL(r.sync_at,0),IFNULL(r.sync_accout,''),
+		IFNULL(r.public_ip,''),IFNULL(r.private_ip,''),IFNULL(r.pay_type,''),r.describe_hash,
+		r.resource_hash,r.secret_id,r.domain,r.namespace,r.env,r.usage_mode,
+		r.shared_tag_key,r.shared_tag_values,r.version 
+	FROM resource r %s JOIN resource_tag t ON r.id = t.resource_id`
 	// 	-- resourceA   t1=v1  t2=v2
 	// -- resourceA  t1=v1
 	// -- resourceA  t2=v2
 	// -- 使用DISTINCT对字段去重
 	// -- 用于分页时使用
 	sqlCountResource = `SELECT COUNT(DISTINCT r.id) FROM resource r %s JOIN resource_tag t ON r.id = t.resource_id`
+	// 按Id批量查询调用方有权访问的资源, 访问条件由Caller.BuildSQL生成
+	sqlQueryVisibleResourceId = `SELECT r.id FROM resource r`
 
-	sqlQueryResourceTag  = `SELECT t_key,t_value,description,resource_id,weight,type FROM resource_tag`
+	sqlQueryResourceTag  = `SELECT t_key,t_value,description,resource_id,weight,is_cost,type,hidden,IFNULL(meta,'') FROM resource_tag`
 	sqlDeleteResourceTag = `
 		DELETE 
 		FROM
@@ -35,14 +64,37 @@ const (
 			AND t_value =?;
 	`
 	sqlInsertOrUpdateResourceTag = `
-		INSERT INTO resource_tag ( type, t_key, t_value, description, resource_id, weight, create_at)
+		INSERT INTO resource_tag ( type, t_key, t_value, description, resource_id, weight, is_cost, hidden, meta, status, create_at, create_by)
 		VALUES
-			( ?,?,?,?,?,?,? ) 
+			( ?,?,?,?,?,?,?,?,?,?,?,? ) 
 			ON DUPLICATE KEY UPDATE description =
 		IF
 			( type != 1,?, description ),
 			weight =
 		IF
-			( type != 1,?, weight );
+			( type != 1,?, weight ),
+			is_cost = ?,
+			hidden = ?,
+			meta = ?,
+			status = 2,
+			update_at = ?,
+			update_by = ?;
 	`
+
+	// 事件与资源的变更在同一个事务中写入, 资源类型从资源表中查询
+	sqlInsertEvent = `INSERT INTO resource_event (resource_id,resource_type,type,create_at,actor) 
+	SELECT id,resource_type,?,?,? FROM resource WHERE id = ?`
+	// 只为本次释放的资源写入事件, 查询之后又被同步到的资源不会被释放
+	sqlInsertReleaseEvent = `INSERT INTO resource_event (resource_id,resource_type,type,create_at,actor) 
+	SELECT id,resource_type,?,?,? FROM resource WHERE id IN (?%s) AND status = 0 AND delete_at = ?`
+	sqlQueryEvent      = `SELECT revision,resource_id,resource_type,type,create_at,actor FROM resource_event`
+	sqlQueryEventRange = `SELECT IFNULL(MIN(revision),0),IFNULL(MAX(revision),0) FROM resource_event`
+
+	sqlInsertRevision = `INSERT INTO resource_revision (
+		id,resource_id,create_at,actor,action,changed_fields,before_snapshot,after_snapshot
+	) VALUES (?,?,?,?,?,?,?,?);`
+	sqlQueryRevision = `SELECT 
+		id,resource_id,create_at,actor,action,changed_fields,IFNULL(before_snapshot,''),after_snapshot 
+	FROM resource_revision`
+	sqlCountRevision = `SELECT COUNT(*) FROM resource_revision`
 )
@@ -0,0 +1,88 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/sqlbuilder"
+)
+
+const (
+	// 流式查询时每批次查询的资源数量的上限
+	maxStreamBatchSize = 1000
+)
+
+// walk 按资源Id递增的游标分批遍历满足条件的资源, 不依赖分页偏移量
+// 遍历过程中新增或者删除资源不会导致重复或者遗漏已经存在的资源, 内存占用只和批次大小有关
+func (s *service) walk(ctx context.Context, req *resource.SearchRequest, batchSize uint64, fn func(*resource.Resource) error) error {
+	cursor := ""
+	for {
+		query, join, err := resource.NewSearchQuery(sqlQueryResource, req)
+		if err != nil {
+			return err
+		}
+		query.Where("r.id > ?", cursor)
+
+		set, err := s.walkBatch(ctx, query, join, batchSize, req.WithTags)
+		if err != nil {
+			return err
+		}
+
+		for i := range set.Items {
+			if err := fn(set.Items[i]); err != nil {
+				return err
+			}
+		}
+
+		// 不足一个批次说明已经遍历完成
+		if uint64(len(set.Items)) < batchSize {
+			return nil
+		}
+		cursor = set.Items[len(set.Items)-1].Id
+	}
+}
+
+func (s *service) walkBatch(ctx context.Context, query *sqlbuilder.Builder, join string, batchSize uint64, withTags bool) (*resource.ResourceSet, error) {
+	set := resource.NewResourceSet()
+
+	querySQL, args := query.GroupBy("r.id").Order("r.id").Asc().Limit(0, uint(batchSize)).Build()
+	s.log.Named("StreamSearch").Debugf("sql: %s; %v", querySQL, args)
+	stmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("StreamSearch").Error(err)
+		return nil, exception.NewInternalServerError("query resource err %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		s.log.Named("StreamSearch").Error(err)
+		return nil, exception.NewInternalServerError("query resource err %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ins, err := scanResource(rows)
+		if err != nil {
+			s.log.Named("StreamSearch").Error(err)
+			return nil, exception.NewInternalServerError("query resource err %s", err)
+		}
+		set.Add(ins)
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("StreamSearch").Error(err)
+		return nil, exception.NewInternalServerError("query resource err %s", err)
+	}
+
+	// 按需补充资源标签
+	if withTags && len(set.Items) > 0 {
+		tags, err := s.queryTag(ctx, resource.NewQueryTagRequest(set.ResourceIds()...))
+		if err != nil {
+			return nil, err
+		}
+		set.UpdateTag(tags)
+	}
+
+	return set, nil
+}
@@ -4,16 +4,385 @@ import (
 	"context"
 
 	"github.com/opengoats/cmdb/apps/resource"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/request"
 )
 
 func (s *service) Search(ctx context.Context, req *resource.SearchRequest) (*resource.ResourceSet, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("Search").Error(err)
+		return nil, exception.NewBadRequest("validate search resource error, %s", err)
+	}
+
+	// 调用方以认证后的身份为准, 忽略请求中传递的值
+	caller, err := resource.NewCallerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Caller = caller
+
+	// 分页默认值填充
+	if req.Page == nil {
+		req.Page = request.NewDefaultPageRequest()
+	}
+	if req.Page.PageSize == 0 {
+		req.Page.PageSize = request.DefaultPageSize
+	}
+	if req.Page.PageNumber == 0 {
+		req.Page.PageNumber = request.DefaultPageNumber
+	}
+
+	// 数据库查询
+	return s.search(ctx, req)
+}
+func (s *service) StreamSearch(req *resource.SearchRequest, stream resource.Service_StreamSearchServer) error {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("StreamSearch").Error(err)
+		return exception.NewBadRequest("validate search resource error, %s", err)
+	}
+
+	// 调用方以认证后的身份为准, 忽略请求中传递的值
+	caller, err := resource.NewCallerFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	req.Caller = caller
+
+	// 分页大小作为每批次查询的数量, 页码被忽略
+	batchSize := uint64(queryBatchSize)
+	if req.Page != nil && req.Page.PageSize > 0 {
+		batchSize = req.Page.PageSize
+	}
+	if batchSize > maxStreamBatchSize {
+		batchSize = maxStreamBatchSize
+	}
+
+	return s.walk(stream.Context(), req, batchSize, stream.Send)
 }
 func (s *service) QueryTag(ctx context.Context, req *resource.QueryTagRequest) (*resource.TagSet, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryTag not implemented")
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("QueryTag").Error(err)
+		return nil, exception.NewBadRequest("validate query tag error, %s", err)
+	}
+
+	// 只返回调用方有权访问的资源的标签
+	caller, err := resource.NewCallerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.ResourceIds, err = s.visibleResourceIds(ctx, req.ResourceIds, caller)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.ResourceIds) == 0 {
+		return resource.NewTagSet(), nil
+	}
+
+	// 数据库查询
+	return s.queryTag(ctx, req)
 }
 func (s *service) UpdateTag(ctx context.Context, req *resource.UpdateTagRequest) (*resource.Resource, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("UpdateTag").Error(err)
+		return nil, exception.NewBadRequest("validate update tag error, %s", err)
+	}
+
+	// 调用方以认证后的身份为准, 忽略请求中传递的值
+	caller, err := resource.NewCallerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Caller = caller
+
+	// 验证资源id,查询不到直接返回
+	ins, err := s.describe(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只允许修改调用方有权访问的资源
+	if !ins.IsVisibleTo(req.Caller) {
+		return nil, exception.NewPermissionDeny("resource %s is not shared to caller", req.Id)
+	}
+	if err := ins.CheckVersion(req.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	// 只允许用户修改USER类型的标签
+	exist, err := s.queryTag(ctx, &resource.QueryTagRequest{ResourceIds: []string{req.Id}, WithHidden: true})
+	if err != nil {
+		return nil, err
+	}
+	if err := req.CheckUserPermission(exist.Items); err != nil {
+		s.log.Named("UpdateTag").Error(err)
+		return nil, exception.NewPermissionDeny("update tag error, %s", err)
+	}
+
+	// 数据库更新, 变更历史在同一个事务中记录
+	if err := s.updateTag(ctx, req, ins); err != nil {
+		return nil, err
+	}
+
+	// 返回更新后的资源标签
+	tags, err := s.queryTag(ctx, &resource.QueryTagRequest{ResourceIds: []string{req.Id}, WithHidden: true})
+	if err != nil {
+		return nil, err
+	}
+	ins.Tags = tags.Items
+	return ins, nil
+}
+
+func (s *service) Save(ctx context.Context, req *resource.SaveRequest) (*resource.SaveResult, error) {
+	return s.SaveWithDescribe(ctx, req, nil)
+}
+
+func (s *service) SaveWithDescribe(ctx context.Context, req *resource.SaveRequest, w resource.DescribeWriter) (*resource.SaveResult, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("Save").Error(err)
+		return nil, exception.NewBadRequest("validate save resource error, %s", err)
+	}
+	if err := req.Resource.Validate(); err != nil {
+		s.log.Named("Save").Error(err)
+		return nil, exception.NewBadRequest("validate save resource error, %s", err)
+	}
+
+	// 数据库保存
+	set, err := s.batchSave(ctx, resource.NewBatchSaveRequest(req), w)
+	if err != nil {
+		return nil, err
+	}
+
+	result := set.Items[0]
+	if result.Status.Equal(resource.SaveStatus_FAILED) {
+		return nil, exception.NewInternalServerError("save resource error, %s", result.Message)
+	}
+	return result, nil
+}
+
+func (s *service) BatchSave(ctx context.Context, req *resource.BatchSaveRequest) (*resource.SaveResultSet, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("BatchSave").Error(err)
+		return nil, exception.NewBadRequest("validate batch save resource error, %s", err)
+	}
+
+	// 数据库保存, 返回每个资源的保存结果
+	return s.batchSave(ctx, req, nil)
+}
+
+func (s *service) SetSharedPolicy(ctx context.Context, req *resource.SetSharedPolicyRequest) (*resource.Resource, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("SetSharedPolicy").Error(err)
+		return nil, exception.NewBadRequest("validate set shared policy error, %s", err)
+	}
+
+	// 调用方以认证后的身份为准, 忽略请求中传递的值
+	caller, err := resource.NewCallerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Caller = caller
+
+	// 验证资源id,查询不到直接返回
+	ins, err := s.describe(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只允许资源所属空间修改共享策略, 独占的资源不允许共享, 只有服务内部调用时调用方为空
+	if req.Caller != nil && ins.Namespace != req.Caller.Namespace {
+		return nil, exception.NewPermissionDeny("only namespace %s can set resource %s shared policy", ins.Namespace, req.Id)
+	}
+	if !ins.UsageMode.Equal(resource.UsageMode_SHARED) {
+		return nil, exception.NewBadRequest("resource %s usage mode is %s, only SHARED resource can set shared policy", req.Id, ins.UsageMode)
+	}
+	if err := ins.CheckVersion(req.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	// 数据库更新
+	if err := s.setSharedPolicy(ctx, ins, req.SharedPolicy); err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
+
+func (s *service) ExpiringResources(ctx context.Context, req *resource.ExpiringResourcesRequest) (*resource.ResourceSet, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("ExpiringResources").Error(err)
+		return nil, exception.NewBadRequest("validate expiring resources error, %s", err)
+	}
+
+	// 调用方以认证后的身份为准, 忽略请求中传递的值
+	caller, err := resource.NewCallerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Caller = caller
+
+	// 默认值填充
+	if req.Page == nil {
+		req.Page = request.NewDefaultPageRequest()
+	}
+	if req.Page.PageSize == 0 {
+		req.Page.PageSize = request.DefaultPageSize
+	}
+	if req.Page.PageNumber == 0 {
+		req.Page.PageNumber = request.DefaultPageNumber
+	}
+	if req.WithinDays == 0 {
+		req.WithinDays = resource.DefaultExpiringWithinDays
+	}
+
+	// 数据库查询
+	return s.expiringResources(ctx, req)
+}
+
+func (s *service) ListResourceRevisions(ctx context.Context, req *resource.ListResourceRevisionsRequest) (*resource.RevisionSet, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("ListResourceRevisions").Error(err)
+		return nil, exception.NewBadRequest("validate list resource revisions error, %s", err)
+	}
+
+	// 只允许查询调用方有权访问的资源的变更历史
+	if err := s.checkVisible(ctx, req.ResourceId); err != nil {
+		return nil, err
+	}
+
+	// 分页默认值填充
+	if req.Page == nil {
+		req.Page = request.NewDefaultPageRequest()
+	}
+	if req.Page.PageSize == 0 {
+		req.Page.PageSize = request.DefaultPageSize
+	}
+	if req.Page.PageNumber == 0 {
+		req.Page.PageNumber = request.DefaultPageNumber
+	}
+
+	// 数据库查询
+	return s.listRevisions(ctx, req)
+}
+
+func (s *service) DiffResourceRevisions(ctx context.Context, req *resource.DiffResourceRevisionsRequest) (*resource.RevisionDiff, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("DiffResourceRevisions").Error(err)
+		return nil, exception.NewBadRequest("validate diff resource revisions error, %s", err)
+	}
+
+	a, err := s.describeRevision(ctx, req.A)
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.describeRevision(ctx, req.B)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只允许对比同一个资源的变更
+	if a.ResourceId != b.ResourceId {
+		return nil, exception.NewBadRequest("revision %s and %s belong to different resources", req.A, req.B)
+	}
+
+	// 只允许对比调用方有权访问的资源的变更
+	if err := s.checkVisible(ctx, a.ResourceId); err != nil {
+		return nil, err
+	}
+	return resource.NewRevisionDiff(a, b), nil
+}
+
+// checkVisible 校验认证后的调用方是否有权访问该资源
+func (s *service) checkVisible(ctx context.Context, id string) error {
+	caller, err := resource.NewCallerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if caller == nil {
+		return nil
+	}
+
+	ins, err := s.describe(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ins.IsVisibleTo(caller) {
+		return exception.NewPermissionDeny("resource %s is not shared to caller", id)
+	}
+	return nil
+}
+
+func (s *service) ReleaseResources(ctx context.Context, req *resource.ReleaseResourcesRequest) (*resource.ReleaseResult, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("ReleaseResources").Error(err)
+		return nil, exception.NewBadRequest("validate release resources error, %s", err)
+	}
+
+	// 数据库更新
+	return s.release(ctx, req)
+}
+
+func (s *service) LookupByIP(ctx context.Context, req *resource.LookupByIPRequest) (*resource.ResourceSet, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("LookupByIP").Error(err)
+		return nil, exception.NewBadRequest("validate lookup by ip error, %s", err)
+	}
+
+	// 调用方以认证后的身份为准, 忽略请求中传递的值
+	caller, err := resource.NewCallerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Caller = caller
+
+	// 通过resource_ip的ip索引查询
+	return s.search(ctx, req.SearchRequest(maxLookupResults))
+}
+
+func (s *service) Aggregate(ctx context.Context, req *resource.AggregateRequest) (*resource.AggregateResult, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("Aggregate").Error(err)
+		return nil, exception.NewBadRequest("validate aggregate error, %s", err)
+	}
+
+	// 调用方以认证后的身份为准, 忽略请求中传递的值
+	caller, err := resource.NewCallerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Filter == nil {
+		req.Filter = resource.NewSearchRequest()
+	}
+	req.Filter.Caller = caller
+
+	// 数据库统计
+	return s.aggregate(ctx, req)
+}
+
+func (s *service) Watch(req *resource.WatchRequest, stream resource.Service_WatchServer) error {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("Watch").Error(err)
+		return exception.NewBadRequest("validate watch error, %s", err)
+	}
+
+	// 调用方以认证后的身份为准, 只推送调用方有权访问的资源的事件
+	caller, err := resource.NewCallerFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	// 持续推送事件, 直到客户端断开
+	return s.watch(stream.Context(), req, caller, stream.Send)
 }
@@ -0,0 +1,193 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/sqlbuilder"
+)
+
+const (
+	// 没有新事件时查询事件表的间隔
+	watchPollInterval = time.Second
+	// 每次查询的事件数量
+	watchBatchSize = 500
+)
+
+// appendEvent 在资源变更的事务中写入事件, 事务回滚时事件一起回滚
+// 需要在事务的最后写入, 缩短版本号分配到事务提交之间的时间
+func (s *service) appendEvent(ctx context.Context, tx *sql.Tx, t resource.EventType, resourceId, actor string) error {
+	s.log.Named("AppendEvent").Debugf("sql: %s", sqlInsertEvent)
+	_, err := tx.ExecContext(ctx, sqlInsertEvent, t, time.Now().UnixMilli(), actor, resourceId)
+	if err != nil {
+		return exception.NewInternalServerError("insert resource event err %s", err)
+	}
+	return nil
+}
+
+// watch 从起始版本号之后按顺序推送事件, 直到客户端断开
+// 多个服务实例共享事件表, 通过轮询获取其他实例写入的事件
+// 只推送调用方有权访问的资源的事件, 调用方为空(服务内部调用)时不过滤
+func (s *service) watch(ctx context.Context, req *resource.WatchRequest, caller *resource.Caller, fn func(*resource.Event) error) error {
+	cursor, err := s.eventCursor(ctx, req.StartRevision)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := s.queryEvents(ctx, cursor.Revision, watchBatchSize)
+		if err != nil {
+			// 客户端断开导致的查询失败不是错误
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		ready := cursor.Advance(events, time.Now())
+		if err := s.sendEvents(ctx, req, caller, ready, fn); err != nil {
+			return err
+		}
+
+		// 整个批次都已经推送说明还有积压的事件, 立即继续查询
+		if len(events) == watchBatchSize && len(ready) == len(events) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// eventCursor 起始版本号为0时从最新的事件开始订阅
+// 起始版本号之后的事件已经被清理时返回错误, 客户端需要重新全量查询后再订阅
+func (s *service) eventCursor(ctx context.Context, startRevision int64) (*resource.EventCursor, error) {
+	var min, max int64
+	s.log.Named("Watch").Debugf("sql: %s", sqlQueryEventRange)
+	if err := s.db.QueryRowContext(ctx, sqlQueryEventRange).Scan(&min, &max); err != nil {
+		s.log.Named("Watch").Error(err)
+		return nil, exception.NewInternalServerError("query resource event range err %s", err)
+	}
+
+	switch {
+	case startRevision == 0:
+		return resource.NewEventCursor(max), nil
+	case max == 0:
+		// 事件表为空时无法校验, 从起始版本号开始
+		return resource.NewEventCursor(startRevision), nil
+	case startRevision < min-1:
+		return nil, exception.NewBadRequest("revision %d has been compacted, the oldest revision is %d", startRevision, min)
+	case startRevision > max:
+		return nil, exception.NewBadRequest("revision %d is greater than the latest revision %d", startRevision, max)
+	}
+	return resource.NewEventCursor(startRevision), nil
+}
+
+func (s *service) queryEvents(ctx context.Context, after int64, limit uint) ([]*resource.Event, error) {
+	query := sqlbuilder.NewQuery(sqlQueryEvent).Where("revision > ?", after)
+	querySQL, args := query.Order("revision").Asc().Limit(0, limit).Build()
+	s.log.Named("Watch").Debugf("sql: %s; %v", querySQL, args)
+
+	rows, err := s.db.QueryContext(ctx, querySQL, args...)
+	if err != nil {
+		s.log.Named("Watch").Error(err)
+		return nil, exception.NewInternalServerError("query resource event err %s", err)
+	}
+	defer rows.Close()
+
+	events := []*resource.Event{}
+	for rows.Next() {
+		e := resource.NewDefaultEvent()
+		if err := rows.Scan(&e.Revision, &e.ResourceId, &e.ResourceType, &e.Type, &e.CreateAt, &e.Actor); err != nil {
+			s.log.Named("Watch").Error(err)
+			return nil, exception.NewInternalServerError("query resource event err %s", err)
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("Watch").Error(err)
+		return nil, exception.NewInternalServerError("query resource event err %s", err)
+	}
+
+	return events, nil
+}
+
+// sendEvents 推送满足订阅条件的事件, 需要时批量补充资源的最新数据
+func (s *service) sendEvents(ctx context.Context, req *resource.WatchRequest, caller *resource.Caller, events []*resource.Event, fn func(*resource.Event) error) error {
+	matched := []*resource.Event{}
+	for i := range events {
+		if req.Match(events[i]) {
+			matched = append(matched, events[i])
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	ids := []string{}
+	for i := range matched {
+		ids = append(ids, matched[i].ResourceId)
+	}
+
+	if req.WithResource {
+		query := sqlbuilder.NewQuery(sqlQueryResource, "LEFT")
+		query.Where("r.id IN (?"+strings.Repeat(",?", len(ids)-1)+")", resource.StringsToArgs(ids)...)
+		if caller != nil {
+			query.Where(caller.BuildSQL())
+		}
+		set, err := s.walkBatch(ctx, query, "LEFT", uint64(len(ids)), true)
+		if err != nil {
+			return err
+		}
+
+		// 已经被清理的资源不携带资源数据
+		resources := map[string]*resource.Resource{}
+		for i := range set.Items {
+			resources[set.Items[i].Id] = set.Items[i]
+		}
+		for i := range matched {
+			matched[i].Resource = resources[matched[i].ResourceId]
+		}
+
+		// 没有加载到资源时, 资源已经被清理或者调用方无权访问, 不推送
+		if caller != nil {
+			matched = filterEvents(matched, func(e *resource.Event) bool { return e.Resource != nil })
+		}
+	} else if caller != nil {
+		visible, err := s.visibleResourceIds(ctx, ids, caller)
+		if err != nil {
+			return err
+		}
+		set := map[string]bool{}
+		for i := range visible {
+			set[visible[i]] = true
+		}
+		matched = filterEvents(matched, func(e *resource.Event) bool { return set[e.ResourceId] })
+	}
+
+	for i := range matched {
+		if err := fn(matched[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func filterEvents(events []*resource.Event, keep func(*resource.Event) bool) []*resource.Event {
+	result := make([]*resource.Event, 0, len(events))
+	for i := range events {
+		if keep(events[i]) {
+			result = append(result, events[i])
+		}
+	}
+	return result
+}
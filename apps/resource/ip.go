@@ -0,0 +1,224 @@
+package resource
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+const (
+	// 地址族
+	IPFamilyV4 = 4
+	IPFamilyV6 = 6
+)
+
+const (
+	// IP过滤条件, 先通过resource_ip的ip索引找到资源Id
+	sqlIPFilter = `r.id IN (SELECT ri.resource_id FROM resource_ip ri WHERE %s)`
+)
+
+// NewResourceIP 解析IP地址, 公网IP字段中可能是域名, 不是IP时返回错误
+func NewResourceIP(resourceId string, t IPType, address string) (*ResourceIP, error) {
+	addr, err := ParseIP(address)
+	if err != nil {
+		return nil, err
+	}
+
+	ip := &ResourceIP{
+		ResourceId: resourceId,
+		Type:       t,
+		Family:     IPFamilyV6,
+		Address:    addr.String(),
+	}
+	if addr.Is4() {
+		ip.Family = IPFamilyV4
+	}
+	return ip, nil
+}
+
+// Bytes 数据库中使用16字节格式存储, IPv4使用IPv4-mapped格式, 保证同一地址族内按字节比较的顺序与地址的顺序一致
+func (ip *ResourceIP) Bytes() []byte {
+	return IPBytes(netip.MustParseAddr(ip.Address))
+}
+
+// IPs 资源所有可以解析为IP的地址, 同一个类型下的重复地址只保留一个
+func (r *Resource) IPs() []*ResourceIP {
+	ips := []*ResourceIP{}
+	seen := map[string]bool{}
+	add := func(t IPType, addresses []string) {
+		for _, address := range addresses {
+			ip, err := NewResourceIP(r.Id, t, address)
+			if err != nil {
+				continue
+			}
+			key := t.String() + "/" + ip.Address
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			ips = append(ips, ip)
+		}
+	}
+	add(IPType_PRIVATE, r.PrivateIp)
+	add(IPType_PUBLIC, r.PublicIp)
+	return ips
+}
+
+// ParseIP 解析IP地址, 去掉IPv6的zone, IPv4-mapped地址按IPv4处理
+func ParseIP(s string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(s))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return addr.WithZone("").Unmap(), nil
+}
+
+// IPBytes IP地址的16字节格式
+func IPBytes(addr netip.Addr) []byte {
+	b := addr.As16()
+	return b[:]
+}
+
+// IPRange 包含两端的IP范围, 两端的地址族必须相同
+type IPRange struct {
+	Start netip.Addr
+	End   netip.Addr
+}
+
+// ParseCIDR 把网段转换为IP范围
+func ParseCIDR(s string) (*IPRange, error) {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(s))
+	if err != nil {
+		return nil, err
+	}
+	prefix = prefix.Masked()
+
+	start := prefix.Addr()
+	if start.Is4In6() {
+		return nil, fmt.Errorf("cidr %s is ipv4-mapped ipv6, use ipv4 cidr instead", s)
+	}
+
+	// 网段的最后一个地址, 主机位全部置1
+	b := start.AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 1 << (7 - i%8)
+	}
+	end, _ := netip.AddrFromSlice(b)
+	return &IPRange{Start: start, End: end}, nil
+}
+
+// ParseIPRange 解析 起始IP-结束IP 格式的范围
+func ParseIPRange(s string) (*IPRange, error) {
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return nil, fmt.Errorf("ip range %s format must be start-end", s)
+	}
+
+	r := &IPRange{}
+	var err error
+	if r.Start, err = ParseIP(start); err != nil {
+		return nil, err
+	}
+	if r.End, err = ParseIP(end); err != nil {
+		return nil, err
+	}
+	if r.Start.Is4() != r.End.Is4() {
+		return nil, fmt.Errorf("ip range %s start and end must be the same family", s)
+	}
+	if r.End.Less(r.Start) {
+		return nil, fmt.Errorf("ip range %s start must be less than or equal to end", s)
+	}
+	return r, nil
+}
+
+// Contains 地址是否在范围内
+func (r *IPRange) Contains(addr netip.Addr) bool {
+	return addr.Is4() == r.Start.Is4() && !addr.Less(r.Start) && !r.End.Less(addr)
+}
+
+// BuildIPFilters 把ip, cidr, ip_range编译为WHERE条件, 多个条件之间为AND关系
+func (r *SearchRequest) BuildIPFilters() (stmts []string, args []interface{}, err error) {
+	filter := func(cond string, condArgs ...interface{}) {
+		if r.IpType != nil {
+			cond += " AND ri.type = ?"
+			condArgs = append(condArgs, *r.IpType)
+		}
+		stmts = append(stmts, fmt.Sprintf(sqlIPFilter, cond))
+		args = append(args, condArgs...)
+	}
+
+	if r.Ip != "" {
+		addr, err := ParseIP(r.Ip)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid ip %s, %s", r.Ip, err)
+		}
+		filter("ri.ip = ?", IPBytes(addr))
+	}
+	if r.Cidr != "" {
+		rg, err := ParseCIDR(r.Cidr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid cidr %s, %s", r.Cidr, err)
+		}
+		filter("ri.ip BETWEEN ? AND ?", IPBytes(rg.Start), IPBytes(rg.End))
+	}
+	if r.IpRange != "" {
+		rg, err := ParseIPRange(r.IpRange)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid ip range %s, %s", r.IpRange, err)
+		}
+		filter("ri.ip BETWEEN ? AND ?", IPBytes(rg.Start), IPBytes(rg.End))
+	}
+	return stmts, args, nil
+}
+
+// HasIPFilter 是否有IP过滤条件
+func (r *SearchRequest) HasIPFilter() bool {
+	return r.Ip != "" || r.Cidr != "" || r.IpRange != ""
+}
+
+func (r *LookupByIPRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+	if _, err := ParseIP(r.Ip); err != nil {
+		return fmt.Errorf("invalid ip %s, %s", r.Ip, err)
+	}
+	return nil
+}
+
+func NewLookupByIPRequest(ip string) *LookupByIPRequest {
+	return &LookupByIPRequest{
+		Ip: ip,
+	}
+}
+
+// NewLookupByIPRequestFromHTTP 从HTTP请求的Query参数中加载查询条件
+func NewLookupByIPRequestFromHTTP(r *http.Request) (*LookupByIPRequest, error) {
+	qs := r.URL.Query()
+
+	req := NewLookupByIPRequest(qs.Get("ip"))
+	req.IncludeReleased = qs.Get("include_released") == "true"
+	req.WithTags = qs.Get("with_tags") == "true"
+	if t := qs.Get("ip_type"); t != "" {
+		v, err := ParseIPTypeFromString(t)
+		if err != nil {
+			return nil, err
+		}
+		req.IpType = &v
+	}
+
+	return req, nil
+}
+
+// SearchRequest 转换为通用的搜索条件, 一个IP对应的资源数量有限, 不需要分页
+func (r *LookupByIPRequest) SearchRequest(pageSize uint64) *SearchRequest {
+	req := NewSearchRequest()
+	req.Page.PageSize = pageSize
+	req.Ip = r.Ip
+	req.IpType = r.IpType
+	req.IncludeReleased = r.IncludeReleased
+	req.WithTags = r.WithTags
+	req.Caller = r.Caller
+	return req
+}
@@ -0,0 +1,127 @@
+package resource_test
+
+import (
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceIPs(t *testing.T) {
+	should := assert.New(t)
+
+	r := resource.NewDefaultResource()
+	r.Id = "r1"
+	r.PrivateIp = []string{"10.0.0.1", " 10.0.0.1", "fe80::1%eth0"}
+	r.PublicIp = []string{"1.2.3.4", "www.example.com", "::ffff:10.0.0.1"}
+
+	ips := r.IPs()
+	if should.Len(ips, 4) {
+		should.Equal(&resource.ResourceIP{ResourceId: "r1", Type: resource.IPType_PRIVATE, Family: 4, Address: "10.0.0.1"}, ips[0])
+		should.Equal(&resource.ResourceIP{ResourceId: "r1", Type: resource.IPType_PRIVATE, Family: 6, Address: "fe80::1"}, ips[1])
+		should.Equal(&resource.ResourceIP{ResourceId: "r1", Type: resource.IPType_PUBLIC, Family: 4, Address: "1.2.3.4"}, ips[2])
+		// IPv4-mapped地址按IPv4处理
+		should.Equal("10.0.0.1", ips[3].Address)
+		should.Equal(ips[0].Bytes(), ips[3].Bytes())
+		should.Len(ips[0].Bytes(), 16)
+	}
+}
+
+func TestParseCIDR(t *testing.T) {
+	should := assert.New(t)
+
+	rg, err := resource.ParseCIDR("10.2.3.4/8")
+	if should.NoError(err) {
+		should.Equal("10.0.0.0", rg.Start.String())
+		should.Equal("10.255.255.255", rg.End.String())
+		should.True(rg.Contains(netip.MustParseAddr("10.2.3.4")))
+		should.False(rg.Contains(netip.MustParseAddr("11.0.0.0")))
+		should.False(rg.Contains(netip.MustParseAddr("::a02:304")))
+	}
+
+	rg, err = resource.ParseCIDR("10.2.3.4/32")
+	if should.NoError(err) {
+		should.Equal(rg.Start, rg.End)
+	}
+
+	rg, err = resource.ParseCIDR("2001:db8::/126")
+	if should.NoError(err) {
+		should.Equal("2001:db8::3", rg.End.String())
+	}
+
+	_, err = resource.ParseCIDR("10.0.0.0")
+	should.Error(err)
+	_, err = resource.ParseCIDR("::ffff:10.0.0.0/104")
+	should.Error(err)
+}
+
+func TestParseIPRange(t *testing.T) {
+	should := assert.New(t)
+
+	rg, err := resource.ParseIPRange("10.0.0.1 - 10.0.0.100")
+	if should.NoError(err) {
+		should.True(rg.Contains(netip.MustParseAddr("10.0.0.100")))
+		should.False(rg.Contains(netip.MustParseAddr("10.0.0.101")))
+	}
+
+	_, err = resource.ParseIPRange("10.0.0.100-10.0.0.1")
+	should.Error(err)
+	_, err = resource.ParseIPRange("10.0.0.1-::1")
+	should.Error(err)
+	_, err = resource.ParseIPRange("10.0.0.1")
+	should.Error(err)
+}
+
+func TestBuildIPFilters(t *testing.T) {
+	should := assert.New(t)
+
+	req := resource.NewSearchRequest()
+	req.Ip = "10.0.0.1"
+	req.Cidr = "10.0.0.0/24"
+	public := resource.IPType_PUBLIC
+	req.IpType = &public
+
+	stmts, args, err := req.BuildIPFilters()
+	if should.NoError(err) {
+		should.Equal([]string{
+			"r.id IN (SELECT ri.resource_id FROM resource_ip ri WHERE ri.ip = ? AND ri.type = ?)",
+			"r.id IN (SELECT ri.resource_id FROM resource_ip ri WHERE ri.ip BETWEEN ? AND ? AND ri.type = ?)",
+		}, stmts)
+		should.Equal([]interface{}{
+			resource.IPBytes(netip.MustParseAddr("10.0.0.1")), public,
+			resource.IPBytes(netip.MustParseAddr("10.0.0.0")), resource.IPBytes(netip.MustParseAddr("10.0.0.255")), public,
+		}, args)
+	}
+	should.NoError(req.Validate())
+
+	// ip_type需要和IP过滤条件一起使用
+	req = resource.NewSearchRequest()
+	req.IpType = &public
+	should.Error(req.Validate())
+
+	req = resource.NewSearchRequest()
+	req.IpRange = "a-b"
+	should.Error(req.Validate())
+	_, _, err = resource.NewSearchQuery("SELECT r.* FROM resource r %s JOIN resource_tag t ON r.id = t.resource_id", req)
+	should.Error(err)
+}
+
+func TestNewLookupByIPRequestFromHTTP(t *testing.T) {
+	should := assert.New(t)
+
+	req, err := resource.NewLookupByIPRequestFromHTTP(httptest.NewRequest("GET", "/lookup?ip=10.2.3.4&ip_type=private&with_tags=true", nil))
+	if should.NoError(err) {
+		should.NoError(req.Validate())
+		should.Equal(resource.IPType_PRIVATE, *req.IpType)
+
+		search := req.SearchRequest(100)
+		should.Equal("10.2.3.4", search.Ip)
+		should.Equal(uint64(100), search.Page.PageSize)
+		should.True(search.WithTags)
+	}
+
+	should.Error(resource.NewLookupByIPRequest("").Validate())
+	should.Error(resource.NewLookupByIPRequest("host-01").Validate())
+}
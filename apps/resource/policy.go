@@ -0,0 +1,141 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opengoats/goat/exception"
+
+	"github.com/opengoats/cmdb/common/auth"
+)
+
+// NewCallerFromContext 从认证后的请求上下文中加载调用方, 请求中传递的调用方不可信, 需要以此为准
+// 1. 服务内部调用返回nil, 表示不做校验
+// 2. 外部的HTTP和gRPC请求没有认证时返回PermissionDeny
+func NewCallerFromContext(ctx context.Context) (*Caller, error) {
+	if auth.IsInternal(ctx) {
+		return nil, nil
+	}
+
+	id := auth.FromContext(ctx)
+	if id == nil {
+		return nil, exception.NewPermissionDeny("caller not authenticated, %s required", auth.AuthorizationHeader)
+	}
+	c := NewCaller(id.Namespace)
+	for k, v := range id.Tags {
+		c.Tags[k] = v
+	}
+	return c, nil
+}
+
+func NewCaller(namespace string) *Caller {
+	return &Caller{
+		Namespace: namespace,
+		Tags:      map[string]string{},
+	}
+}
+
+// Actor 调用方的标识, 用于记录变更人, 规则与认证身份的标识一致
+func (c *Caller) Actor() string {
+	if c == nil {
+		return ""
+	}
+	return (&auth.Identity{Namespace: c.Namespace, Tags: c.Tags}).Actor()
+}
+
+// BuildSQL 把调用方的访问权限编译为WHERE条件
+// 1. 调用方所属空间的资源都可以访问
+// 2. 共享的资源, 没有设置共享策略时允许所有调用方访问, 否则调用方的标签需要在共享策略中
+func (c *Caller) BuildSQL() (string, []interface{}) {
+	conds := []string{}
+	args := []interface{}{}
+	if c.Namespace != "" {
+		conds = append(conds, "r.namespace = ?")
+		args = append(args, c.Namespace)
+	}
+
+	shared := []string{"r.shared_tag_key = ''"}
+	sharedArgs := []interface{}{UsageMode_SHARED}
+	keys := make([]string, 0, len(c.Tags))
+	for k := range c.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		shared = append(shared, "(r.shared_tag_key = ? AND FIND_IN_SET(?, r.shared_tag_values))")
+		sharedArgs = append(sharedArgs, k, c.Tags[k])
+	}
+	conds = append(conds, "(r.usage_mode = ? AND ("+strings.Join(shared, " OR ")+"))")
+	args = append(args, sharedArgs...)
+
+	return "(" + strings.Join(conds, " OR ") + ")", args
+}
+
+// IsVisibleTo 调用方是否有权访问该资源, 与Caller.BuildSQL的规则保持一致
+func (r *Resource) IsVisibleTo(c *Caller) bool {
+	if c == nil {
+		return true
+	}
+	if c.Namespace != "" && r.Namespace == c.Namespace {
+		return true
+	}
+	if !r.UsageMode.Equal(UsageMode_SHARED) {
+		return false
+	}
+	return r.SharedPolicy.IsAllowed(c)
+}
+
+// IsAllowed 共享策略是否允许该调用方, 没有设置共享维度时允许所有调用方
+func (p *SharedPolicy) IsAllowed(c *Caller) bool {
+	if p == nil || p.TagKey == "" {
+		return true
+	}
+
+	v, ok := c.Tags[p.TagKey]
+	if !ok {
+		return false
+	}
+	for i := range p.TagValues {
+		if p.TagValues[i] == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *SharedPolicy) Validate() error {
+	if err := validate.Struct(p); err != nil {
+		return err
+	}
+	if p.TagKey == "" && len(p.TagValues) > 0 {
+		return fmt.Errorf("shared policy tag_key required when tag_values set")
+	}
+	return nil
+}
+
+// TagValuesToString 数据库中多个值以逗号分隔存储
+func (p *SharedPolicy) TagValuesToString() string {
+	return strings.Join(p.TagValues, ",")
+}
+
+func (p *SharedPolicy) LoadTagValuesString(s string) {
+	if s != "" {
+		p.TagValues = strings.Split(s, ",")
+	}
+}
+
+func (r *SetSharedPolicyRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+	return r.SharedPolicy.Validate()
+}
+
+func NewSetSharedPolicyRequest(id string) *SetSharedPolicyRequest {
+	return &SetSharedPolicyRequest{
+		Id:           id,
+		SharedPolicy: &SharedPolicy{},
+	}
+}
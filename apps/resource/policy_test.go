@@ -0,0 +1,96 @@
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/common/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceIsVisibleTo(t *testing.T) {
+	should := assert.New(t)
+
+	app1 := resource.NewCaller("team-a")
+	app1.Tags["app"] = "app1"
+	app3 := resource.NewCaller("team-b")
+	app3.Tags["app"] = "app3"
+
+	// 没有共享策略的共享资源, 所有调用方都可以访问
+	ins := resource.NewDefaultResource()
+	ins.Namespace = "team-a"
+	should.True(ins.IsVisibleTo(nil))
+	should.True(ins.IsVisibleTo(app3))
+
+	// 共享策略只允许app1, app2
+	ins.SharedPolicy = &resource.SharedPolicy{TagKey: "app", TagValues: []string{"app1", "app2"}}
+	should.True(ins.IsVisibleTo(app1))
+	should.False(ins.IsVisibleTo(app3))
+	should.False(ins.IsVisibleTo(resource.NewCaller("")))
+
+	// 独占的资源只允许所属空间访问
+	ins.UsageMode = resource.UsageMode_MONOPOLY
+	ins.Namespace = "team-b"
+	should.False(ins.IsVisibleTo(app1))
+	should.True(ins.IsVisibleTo(app3))
+}
+
+func TestCallerBuildSQL(t *testing.T) {
+	should := assert.New(t)
+
+	c := resource.NewCaller("team-a")
+	c.Tags["user"] = "bob"
+	c.Tags["app"] = "app1"
+	stmt, args := c.BuildSQL()
+	should.Equal("(r.namespace = ? OR (r.usage_mode = ? AND (r.shared_tag_key = '' OR "+
+		"(r.shared_tag_key = ? AND FIND_IN_SET(?, r.shared_tag_values)) OR "+
+		"(r.shared_tag_key = ? AND FIND_IN_SET(?, r.shared_tag_values)))))", stmt)
+	should.Equal([]interface{}{"team-a", resource.UsageMode_SHARED, "app", "app1", "user", "bob"}, args)
+
+	stmt, args = resource.NewCaller("").BuildSQL()
+	should.Equal("((r.usage_mode = ? AND (r.shared_tag_key = '')))", stmt)
+	should.Equal([]interface{}{resource.UsageMode_SHARED}, args)
+}
+
+func TestNewCallerFromContext(t *testing.T) {
+	should := assert.New(t)
+
+	// 外部请求没有认证
+	_, err := resource.NewCallerFromContext(context.Background())
+	should.Error(err)
+
+	// 服务内部调用不做校验
+	c, err := resource.NewCallerFromContext(auth.NewInternalContext(context.Background()))
+	should.NoError(err)
+	should.Nil(c)
+
+	ctx := auth.WithIdentity(context.Background(), &auth.Identity{
+		Name:      "app1",
+		Namespace: "team-a",
+		Tags:      map[string]string{"app": "app1"},
+	})
+	c, err = resource.NewCallerFromContext(ctx)
+	if should.NoError(err) {
+		should.Equal("team-a", c.Namespace)
+		should.Equal(map[string]string{"app": "app1"}, c.Tags)
+		should.Equal("app:app1", c.Actor())
+	}
+}
+
+func TestSetSharedPolicyRequestValidate(t *testing.T) {
+	should := assert.New(t)
+
+	req := resource.NewSetSharedPolicyRequest("r1")
+	should.NoError(req.Validate())
+
+	req.SharedPolicy.TagValues = []string{"app1"}
+	should.Error(req.Validate())
+
+	req.SharedPolicy.TagKey = "app"
+	should.NoError(req.Validate())
+
+	// 值以逗号分隔存储, 不允许包含逗号
+	req.SharedPolicy.TagValues = []string{"app1,app2"}
+	should.Error(req.Validate())
+}
@@ -272,6 +272,275 @@ func (UpdateAction) EnumDescriptor() ([]byte, []int) {
 	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{4}
 }
 
+// 保存结果
+type SaveStatus int32
+
+const (
+	// 新建
+	SaveStatus_CREATED SaveStatus = 0
+	// 更新
+	SaveStatus_UPDATED SaveStatus = 1
+	// 没有变化, 跳过写入
+	SaveStatus_UNCHANGED SaveStatus = 2
+	// 保存失败
+	SaveStatus_FAILED SaveStatus = 3
+)
+
+// Enum value maps for SaveStatus.
+var (
+	SaveStatus_name = map[int32]string{
+		0: "CREATED",
+		1: "UPDATED",
+		2: "UNCHANGED",
+		3: "FAILED",
+	}
+	SaveStatus_value = map[string]int32{
+		"CREATED":   0,
+		"UPDATED":   1,
+		"UNCHANGED": 2,
+		"FAILED":    3,
+	}
+)
+
+func (x SaveStatus) Enum() *SaveStatus {
+	p := new(SaveStatus)
+	*p = x
+	return p
+}
+
+func (x SaveStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SaveStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_resource_pb_resource_proto_enumTypes[5].Descriptor()
+}
+
+func (SaveStatus) Type() protoreflect.EnumType {
+	return &file_apps_resource_pb_resource_proto_enumTypes[5]
+}
+
+func (x SaveStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SaveStatus.Descriptor instead.
+func (SaveStatus) EnumDescriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{5}
+}
+
+// 变更类型
+type RevisionAction int32
+
+const (
+	// 新建资源
+	RevisionAction_CREATE RevisionAction = 0
+	// 同步时资源信息变化
+	RevisionAction_UPDATE RevisionAction = 1
+	// 用户修改标签
+	RevisionAction_UPDATE_TAG RevisionAction = 2
+)
+
+// Enum value maps for RevisionAction.
+var (
+	RevisionAction_name = map[int32]string{
+		0: "CREATE",
+		1: "UPDATE",
+		2: "UPDATE_TAG",
+	}
+	RevisionAction_value = map[string]int32{
+		"CREATE":     0,
+		"UPDATE":     1,
+		"UPDATE_TAG": 2,
+	}
+)
+
+func (x RevisionAction) Enum() *RevisionAction {
+	p := new(RevisionAction)
+	*p = x
+	return p
+}
+
+func (x RevisionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevisionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_resource_pb_resource_proto_enumTypes[6].Descriptor()
+}
+
+func (RevisionAction) Type() protoreflect.EnumType {
+	return &file_apps_resource_pb_resource_proto_enumTypes[6]
+}
+
+func (x RevisionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevisionAction.Descriptor instead.
+func (RevisionAction) EnumDescriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{6}
+}
+
+// 字段的变化方式
+type DiffOperation int32
+
+const (
+	// 字段新增
+	DiffOperation_ADDED DiffOperation = 0
+	// 字段移除
+	DiffOperation_REMOVED DiffOperation = 1
+	// 字段修改
+	DiffOperation_MODIFIED DiffOperation = 2
+)
+
+// Enum value maps for DiffOperation.
+var (
+	DiffOperation_name = map[int32]string{
+		0: "ADDED",
+		1: "REMOVED",
+		2: "MODIFIED",
+	}
+	DiffOperation_value = map[string]int32{
+		"ADDED":    0,
+		"REMOVED":  1,
+		"MODIFIED": 2,
+	}
+)
+
+func (x DiffOperation) Enum() *DiffOperation {
+	p := new(DiffOperation)
+	*p = x
+	return p
+}
+
+func (x DiffOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_resource_pb_resource_proto_enumTypes[7].Descriptor()
+}
+
+func (DiffOperation) Type() protoreflect.EnumType {
+	return &file_apps_resource_pb_resource_proto_enumTypes[7]
+}
+
+func (x DiffOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffOperation.Descriptor instead.
+func (DiffOperation) EnumDescriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{7}
+}
+
+// IP类型
+type IPType int32
+
+const (
+	// 内网IP
+	IPType_PRIVATE IPType = 0
+	// 公网IP
+	IPType_PUBLIC IPType = 1
+)
+
+// Enum value maps for IPType.
+var (
+	IPType_name = map[int32]string{
+		0: "PRIVATE",
+		1: "PUBLIC",
+	}
+	IPType_value = map[string]int32{
+		"PRIVATE": 0,
+		"PUBLIC":  1,
+	}
+)
+
+func (x IPType) Enum() *IPType {
+	p := new(IPType)
+	*p = x
+	return p
+}
+
+func (x IPType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IPType) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_resource_pb_resource_proto_enumTypes[8].Descriptor()
+}
+
+func (IPType) Type() protoreflect.EnumType {
+	return &file_apps_resource_pb_resource_proto_enumTypes[8]
+}
+
+func (x IPType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IPType.Descriptor instead.
+func (IPType) EnumDescriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{8}
+}
+
+// 资源变更事件类型
+type EventType int32
+
+const (
+	// 新建资源
+	EventType_RESOURCE_CREATED EventType = 0
+	// 同步时资源信息变化, 包括已经释放的资源重新同步到
+	EventType_RESOURCE_UPDATED EventType = 1
+	// 资源在厂商侧已经释放
+	EventType_RESOURCE_DELETED EventType = 2
+	// 用户修改标签
+	EventType_RESOURCE_TAG_CHANGED EventType = 3
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "RESOURCE_CREATED",
+		1: "RESOURCE_UPDATED",
+		2: "RESOURCE_DELETED",
+		3: "RESOURCE_TAG_CHANGED",
+	}
+	EventType_value = map[string]int32{
+		"RESOURCE_CREATED":     0,
+		"RESOURCE_UPDATED":     1,
+		"RESOURCE_DELETED":     2,
+		"RESOURCE_TAG_CHANGED": 3,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_resource_pb_resource_proto_enumTypes[9].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_apps_resource_pb_resource_proto_enumTypes[9]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{9}
+}
+
 type Resource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -382,6 +651,9 @@ type Resource struct {
 	// 实例付费方式, 按量, 包年包月, 买断(自己针对IDC)
 	// @gotags: json:"pay_type"
 	PayType string `protobuf:"bytes,35,opt,name=pay_type,json=payType,proto3" json:"pay_type"`
+	// 版本号, 每次变更加1, 用于乐观锁, HTTP接口通过ETag返回
+	// @gotags: json:"version"
+	Version int64 `protobuf:"varint,36,opt,name=version,proto3" json:"version"`
 }
 
 func (x *Resource) Reset() {
@@ -661,6 +933,13 @@ func (x *Resource) GetPayType() string {
 	return ""
 }
 
+func (x *Resource) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // 共享策略
 type SharedPolicy struct {
 	state         protoimpl.MessageState
@@ -671,8 +950,8 @@ type SharedPolicy struct {
 	// @gotags: json:"tag_key"
 	TagKey string `protobuf:"bytes,1,opt,name=tag_key,json=tagKey,proto3" json:"tag_key"`
 	// 分享给哪些值, app1,app2,app3,   user1,user2,user3
-	// @gotags: json:"tag_values"
-	TagValues []string `protobuf:"bytes,2,rep,name=tag_values,json=tagValues,proto3" json:"tag_values"`
+	// @gotags: json:"tag_values" validate:"dive,excludes=0x2C"
+	TagValues []string `protobuf:"bytes,2,rep,name=tag_values,json=tagValues,proto3" json:"tag_values" validate:"dive,excludes=0x2C"`
 }
 
 func (x *SharedPolicy) Reset() {
@@ -721,6 +1000,67 @@ func (x *SharedPolicy) GetTagValues() []string {
 	return nil
 }
 
+// 调用方, 用于校验资源的使用方式和共享策略, 由服务端根据认证后的身份填充, 客户端传递的值会被忽略
+// 只有服务内部调用时为空, 不做校验
+type Caller struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 调用方所属空间, 独占的资源只允许所属空间访问
+	// @gotags: json:"namespace"
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace"`
+	// 调用方的身份标签, 比如 app=app1, user=user1, 用于匹配共享策略
+	// @gotags: json:"tags"
+	Tags map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Caller) Reset() {
+	*x = Caller{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Caller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Caller) ProtoMessage() {}
+
+func (x *Caller) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Caller.ProtoReflect.Descriptor instead.
+func (*Caller) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Caller) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Caller) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type Tag struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -760,14 +1100,14 @@ type Tag struct {
 	// @gotags: json:"value" validate:"lte=255,required"
 	Value string `protobuf:"bytes,11,opt,name=value,proto3" json:"value" validate:"lte=255,required"`
 	// 标签的值的描述, 通常用于展示, 财务系统a
-	// @gotags: json:"describe"
-	Describe string `protobuf:"bytes,12,opt,name=describe,proto3" json:"describe"`
+	// @gotags: json:"describe" validate:"lte=255"
+	Describe string `protobuf:"bytes,12,opt,name=describe,proto3" json:"describe" validate:"lte=255"`
 	// 标签权重, 针对同一个key, 多个value场景, 默认值1
 	// 有一个资源A， 费用100, 被多个业务方共同使用, 出业务成本, 面临这个成本如何分摊的问题?
 	// 为了让分摊更加灵活, 添加标签的权重, 更加权重的值做具体分摊比例计算, 比如 a:1(1/4), b:2(2/4), c:1(1/4)
 	// 默认公平分摊, 默认就是1, 更加自由使用量俩进行分摊, 外部系统(监控系统) 通过使用计算出权重, 设置过来
-	// @gotags: json:"weight"
-	Weight int64 `protobuf:"varint,13,opt,name=weight,proto3" json:"weight"`
+	// @gotags: json:"weight" validate:"gte=0,lte=10000"
+	Weight int64 `protobuf:"varint,13,opt,name=weight,proto3" json:"weight" validate:"gte=0,lte=10000"`
 	// 标签是否纳入成本统计, 比如监控标签就不需要纳入到成本统计
 	// @gotags: json:"is_cost"
 	IsCost bool `protobuf:"varint,14,opt,name=is_cost,json=isCost,proto3" json:"is_cost"`
@@ -782,7 +1122,7 @@ type Tag struct {
 func (x *Tag) Reset() {
 	*x = Tag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_resource_pb_resource_proto_msgTypes[2]
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -795,7 +1135,7 @@ func (x *Tag) String() string {
 func (*Tag) ProtoMessage() {}
 
 func (x *Tag) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_resource_pb_resource_proto_msgTypes[2]
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +1148,7 @@ func (x *Tag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tag.ProtoReflect.Descriptor instead.
 func (*Tag) Descriptor() ([]byte, []int) {
-	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{2}
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Tag) GetResourceId() string {
@@ -968,12 +1308,30 @@ type SearchRequest struct {
 	// 是否精确匹配, 比如你要匹配IP, 10,10.1.1   10.10.1.1xx
 	// @gotags: json:"exact_match"
 	ExactMatch bool `protobuf:"varint,15,opt,name=exact_match,json=exactMatch,proto3" json:"exact_match"`
+	// 调用方, 只返回调用方有权访问的资源
+	// @gotags: json:"caller"
+	Caller *Caller `protobuf:"bytes,16,opt,name=caller,proto3" json:"caller"`
+	// 是否包含已经释放的资源(厂商侧已经删除), 默认不包含
+	// @gotags: json:"include_released"
+	IncludeReleased bool `protobuf:"varint,17,opt,name=include_released,json=includeReleased,proto3" json:"include_released"`
+	// 拥有该IP的资源, 精确匹配
+	// @gotags: json:"ip"
+	Ip string `protobuf:"bytes,18,opt,name=ip,proto3" json:"ip"`
+	// 拥有该网段内IP的资源, 比如 10.0.0.0/8
+	// @gotags: json:"cidr"
+	Cidr string `protobuf:"bytes,19,opt,name=cidr,proto3" json:"cidr"`
+	// 拥有该范围内IP的资源, 格式: 起始IP-结束IP, 包含两端, 比如 10.0.0.1-10.0.0.100
+	// @gotags: json:"ip_range"
+	IpRange string `protobuf:"bytes,20,opt,name=ip_range,json=ipRange,proto3" json:"ip_range"`
+	// 只匹配某种类型的IP, 需要和ip, cidr, ip_range一起使用
+	// @gotags: json:"ip_type"
+	IpType *IPType `protobuf:"varint,21,opt,name=ip_type,json=ipType,proto3,enum=opengoats.cmdb.resource.IPType,oneof" json:"ip_type"`
 }
 
 func (x *SearchRequest) Reset() {
 	*x = SearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_resource_pb_resource_proto_msgTypes[3]
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -986,7 +1344,7 @@ func (x *SearchRequest) String() string {
 func (*SearchRequest) ProtoMessage() {}
 
 func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_resource_pb_resource_proto_msgTypes[3]
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,7 +1357,7 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{3}
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SearchRequest) GetPage() *request.PageRequest {
@@ -1093,6 +1451,48 @@ func (x *SearchRequest) GetExactMatch() bool {
 	return false
 }
 
+func (x *SearchRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetIncludeReleased() bool {
+	if x != nil {
+		return x.IncludeReleased
+	}
+	return false
+}
+
+func (x *SearchRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetIpRange() string {
+	if x != nil {
+		return x.IpRange
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetIpType() IPType {
+	if x != nil && x.IpType != nil {
+		return *x.IpType
+	}
+	return IPType_PRIVATE
+}
+
 // Tag选择器, 通过key value进行匹配, app-atrr1, app-atrr2
 // 以下连个标签共同组成一套业务逻辑, 需要过滤: promethues.io 开头的标签
 // promethues.io/port = "xxxx"
@@ -1102,10 +1502,10 @@ type TagSelector struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 匹配的key, 支持LIKE匹配
+	// 匹配的key, 包含*时使用通配符匹配, 使用/包裹时使用正则匹配, 比如 promethues.io/*, /^promethues\.io/
 	// @gotags: json:"key"
 	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
-	// 匹配符
+	// 匹配符: =, !=, in, not in, exists, not exists, like(通配符), =~(正则), !~(正则取反)
 	// @gotags: json:"operator"
 	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator"`
 	// 匹配的值, 支持多个值匹配
@@ -1116,7 +1516,7 @@ type TagSelector struct {
 func (x *TagSelector) Reset() {
 	*x = TagSelector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_resource_pb_resource_proto_msgTypes[4]
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1129,7 +1529,7 @@ func (x *TagSelector) String() string {
 func (*TagSelector) ProtoMessage() {}
 
 func (x *TagSelector) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_resource_pb_resource_proto_msgTypes[4]
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,7 +1542,7 @@ func (x *TagSelector) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagSelector.ProtoReflect.Descriptor instead.
 func (*TagSelector) Descriptor() ([]byte, []int) {
-	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{4}
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TagSelector) GetKey() string {
@@ -1180,7 +1580,7 @@ type ResourceSet struct {
 func (x *ResourceSet) Reset() {
 	*x = ResourceSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_resource_pb_resource_proto_msgTypes[5]
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1193,7 +1593,7 @@ func (x *ResourceSet) String() string {
 func (*ResourceSet) ProtoMessage() {}
 
 func (x *ResourceSet) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_resource_pb_resource_proto_msgTypes[5]
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1206,7 +1606,7 @@ func (x *ResourceSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceSet.ProtoReflect.Descriptor instead.
 func (*ResourceSet) Descriptor() ([]byte, []int) {
-	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{5}
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ResourceSet) GetTotal() int64 {
@@ -1228,15 +1628,18 @@ type QueryTagRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 资源id
-	// @gotags: json:"resource_ids"
-	ResourceIds []string `protobuf:"bytes,1,rep,name=resource_ids,json=resourceIds,proto3" json:"resource_ids"`
+	// 资源id, 只返回调用方有权访问的资源的标签
+	// @gotags: json:"resource_ids" validate:"required"
+	ResourceIds []string `protobuf:"bytes,1,rep,name=resource_ids,json=resourceIds,proto3" json:"resource_ids" validate:"required"`
+	// 是否返回隐藏的标签, 默认不返回
+	// @gotags: json:"with_hidden"
+	WithHidden bool `protobuf:"varint,2,opt,name=with_hidden,json=withHidden,proto3" json:"with_hidden"`
 }
 
 func (x *QueryTagRequest) Reset() {
 	*x = QueryTagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_resource_pb_resource_proto_msgTypes[6]
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1249,7 +1652,7 @@ func (x *QueryTagRequest) String() string {
 func (*QueryTagRequest) ProtoMessage() {}
 
 func (x *QueryTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_resource_pb_resource_proto_msgTypes[6]
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1262,7 +1665,7 @@ func (x *QueryTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryTagRequest.ProtoReflect.Descriptor instead.
 func (*QueryTagRequest) Descriptor() ([]byte, []int) {
-	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{6}
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *QueryTagRequest) GetResourceIds() []string {
@@ -1272,6 +1675,13 @@ func (x *QueryTagRequest) GetResourceIds() []string {
 	return nil
 }
 
+func (x *QueryTagRequest) GetWithHidden() bool {
+	if x != nil {
+		return x.WithHidden
+	}
+	return false
+}
+
 type TagSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1286,7 +1696,7 @@ type TagSet struct {
 func (x *TagSet) Reset() {
 	*x = TagSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_resource_pb_resource_proto_msgTypes[7]
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1299,7 +1709,7 @@ func (x *TagSet) String() string {
 func (*TagSet) ProtoMessage() {}
 
 func (x *TagSet) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_resource_pb_resource_proto_msgTypes[7]
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1312,7 +1722,7 @@ func (x *TagSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagSet.ProtoReflect.Descriptor instead.
 func (*TagSet) Descriptor() ([]byte, []int) {
-	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{7}
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TagSet) GetTotal() int64 {
@@ -1340,15 +1750,21 @@ type UpdateTagRequest struct {
 	// 资源id
 	// @gotags: json:"action"
 	Action UpdateAction `protobuf:"varint,2,opt,name=action,proto3,enum=opengoats.cmdb.resource.UpdateAction" json:"action"`
-	// 需要修改的资源标签
-	// @gotags: json:"tags" validate:"required"
-	Tags []*Tag `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags" validate:"required"`
+	// 需要修改的资源标签, 标签的create_by和update_by以认证后的调用方为准
+	// @gotags: json:"tags" validate:"required,dive"
+	Tags []*Tag `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags" validate:"required,dive"`
+	// 调用方, 只允许修改调用方有权访问的资源
+	// @gotags: json:"caller"
+	Caller *Caller `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller"`
+	// 期望的资源版本号, 与当前版本不一致时修改失败, 0表示不校验
+	// @gotags: json:"expected_version" validate:"gte=0"
+	ExpectedVersion int64 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version" validate:"gte=0"`
 }
 
 func (x *UpdateTagRequest) Reset() {
 	*x = UpdateTagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_resource_pb_resource_proto_msgTypes[8]
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1361,7 +1777,7 @@ func (x *UpdateTagRequest) String() string {
 func (*UpdateTagRequest) ProtoMessage() {}
 
 func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_resource_pb_resource_proto_msgTypes[8]
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,7 +1790,7 @@ func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
-	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{8}
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateTagRequest) GetId() string {
@@ -1398,8 +1814,1694 @@ func (x *UpdateTagRequest) GetTags() []*Tag {
 	return nil
 }
 
-var File_apps_resource_pb_resource_proto protoreflect.FileDescriptor
-
+func (x *UpdateTagRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *UpdateTagRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type SaveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源通用信息, 通过(vendor, cid)判断资源是否已经存在
+	// @gotags: json:"resource" validate:"required"
+	Resource *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource" validate:"required"`
+	// 资源特有属性, 比如主机的cpu, 内存, 用于计算describe_hash
+	// @gotags: json:"describe"
+	Describe map[string]string `protobuf:"bytes,2,rep,name=describe,proto3" json:"describe" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// 只比对资源是否存在和是否有变化, 不写入数据库
+	// @gotags: json:"dry_run"
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+}
+
+func (x *SaveRequest) Reset() {
+	*x = SaveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRequest) ProtoMessage() {}
+
+func (x *SaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveRequest.ProtoReflect.Descriptor instead.
+func (*SaveRequest) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SaveRequest) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *SaveRequest) GetDescribe() map[string]string {
+	if x != nil {
+		return x.Describe
+	}
+	return nil
+}
+
+func (x *SaveRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BatchSaveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: json:"items" validate:"required"
+	Items []*SaveRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items" validate:"required"`
+}
+
+func (x *BatchSaveRequest) Reset() {
+	*x = BatchSaveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSaveRequest) ProtoMessage() {}
+
+func (x *BatchSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSaveRequest.ProtoReflect.Descriptor instead.
+func (*BatchSaveRequest) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchSaveRequest) GetItems() []*SaveRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SaveResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源id
+	// @gotags: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// 厂商
+	// @gotags: json:"vendor"
+	Vendor Vendor `protobuf:"varint,2,opt,name=vendor,proto3,enum=opengoats.cmdb.resource.Vendor" json:"vendor"`
+	// 云商自己的Id
+	// @gotags: json:"c_id"
+	Cid string `protobuf:"bytes,3,opt,name=cid,proto3" json:"c_id"`
+	// 保存结果
+	// @gotags: json:"status"
+	Status SaveStatus `protobuf:"varint,4,opt,name=status,proto3,enum=opengoats.cmdb.resource.SaveStatus" json:"status"`
+	// Resource信息是否有变化
+	// @gotags: json:"resource_hash_changed"
+	ResourceHashChanged bool `protobuf:"varint,5,opt,name=resource_hash_changed,json=resourceHashChanged,proto3" json:"resource_hash_changed"`
+	// Describe信息释放有变化
+	// @gotags: json:"describe_hash_changed"
+	DescribeHashChanged bool `protobuf:"varint,6,opt,name=describe_hash_changed,json=describeHashChanged,proto3" json:"describe_hash_changed"`
+	// 失败原因
+	// @gotags: json:"message,omitempty"
+	Message string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SaveResult) Reset() {
+	*x = SaveResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveResult) ProtoMessage() {}
+
+func (x *SaveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveResult.ProtoReflect.Descriptor instead.
+func (*SaveResult) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SaveResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SaveResult) GetVendor() Vendor {
+	if x != nil {
+		return x.Vendor
+	}
+	return Vendor_ALIYUN
+}
+
+func (x *SaveResult) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *SaveResult) GetStatus() SaveStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SaveStatus_CREATED
+}
+
+func (x *SaveResult) GetResourceHashChanged() bool {
+	if x != nil {
+		return x.ResourceHashChanged
+	}
+	return false
+}
+
+func (x *SaveResult) GetDescribeHashChanged() bool {
+	if x != nil {
+		return x.DescribeHashChanged
+	}
+	return false
+}
+
+func (x *SaveResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SaveResultSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	// @gotags: json:"items"
+	Items []*SaveResult `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+}
+
+func (x *SaveResultSet) Reset() {
+	*x = SaveResultSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveResultSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveResultSet) ProtoMessage() {}
+
+func (x *SaveResultSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveResultSet.ProtoReflect.Descriptor instead.
+func (*SaveResultSet) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SaveResultSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SaveResultSet) GetItems() []*SaveResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SetSharedPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源id
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required"`
+	// 共享策略, tag_key为空时表示共享给所有调用方
+	// @gotags: json:"shared_policy" validate:"required"
+	SharedPolicy *SharedPolicy `protobuf:"bytes,2,opt,name=shared_policy,json=sharedPolicy,proto3" json:"shared_policy" validate:"required"`
+	// 调用方, 只允许资源所属空间修改共享策略
+	// @gotags: json:"caller"
+	Caller *Caller `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller"`
+	// 期望的资源版本号, 与当前版本不一致时修改失败, 0表示不校验
+	// @gotags: json:"expected_version" validate:"gte=0"
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version" validate:"gte=0"`
+}
+
+func (x *SetSharedPolicyRequest) Reset() {
+	*x = SetSharedPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSharedPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSharedPolicyRequest) ProtoMessage() {}
+
+func (x *SetSharedPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSharedPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetSharedPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetSharedPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetSharedPolicyRequest) GetSharedPolicy() *SharedPolicy {
+	if x != nil {
+		return x.SharedPolicy
+	}
+	return nil
+}
+
+func (x *SetSharedPolicyRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *SetSharedPolicyRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ExpiringResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分页参数
+	// @gotags: json:"page"
+	Page *request.PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page"`
+	// 多少天内过期, 默认30天, 已经过期的资源不返回
+	// @gotags: json:"within_days" validate:"gte=0,lte=3650"
+	WithinDays int64 `protobuf:"varint,2,opt,name=within_days,json=withinDays,proto3" json:"within_days" validate:"gte=0,lte=3650"`
+	// 付费方式, 忽略大小写, 默认为各个厂商包年包月的付费方式
+	// @gotags: json:"pay_types"
+	PayTypes []string `protobuf:"bytes,3,rep,name=pay_types,json=payTypes,proto3" json:"pay_types"`
+	// 资源所属空间
+	// @gotags: json:"namespace"
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace"`
+	// 资源所属环境
+	// @gotags: json:"env"
+	Env string `protobuf:"bytes,5,opt,name=env,proto3" json:"env"`
+	// 厂商
+	// @gotags: json:"vendor"
+	Vendor *Vendor `protobuf:"varint,6,opt,name=vendor,proto3,enum=opengoats.cmdb.resource.Vendor,oneof" json:"vendor"`
+	// 资源类型
+	// @gotags: json:"type"
+	Type *Type `protobuf:"varint,7,opt,name=type,proto3,enum=opengoats.cmdb.resource.Type,oneof" json:"type"`
+	// 是否返回资源的标签
+	// @gotags: json:"with_tags"
+	WithTags bool `protobuf:"varint,8,opt,name=with_tags,json=withTags,proto3" json:"with_tags"`
+	// 调用方, 只返回调用方有权访问的资源
+	// @gotags: json:"caller"
+	Caller *Caller `protobuf:"bytes,9,opt,name=caller,proto3" json:"caller"`
+}
+
+func (x *ExpiringResourcesRequest) Reset() {
+	*x = ExpiringResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpiringResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiringResourcesRequest) ProtoMessage() {}
+
+func (x *ExpiringResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiringResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ExpiringResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExpiringResourcesRequest) GetPage() *request.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ExpiringResourcesRequest) GetWithinDays() int64 {
+	if x != nil {
+		return x.WithinDays
+	}
+	return 0
+}
+
+func (x *ExpiringResourcesRequest) GetPayTypes() []string {
+	if x != nil {
+		return x.PayTypes
+	}
+	return nil
+}
+
+func (x *ExpiringResourcesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExpiringResourcesRequest) GetEnv() string {
+	if x != nil {
+		return x.Env
+	}
+	return ""
+}
+
+func (x *ExpiringResourcesRequest) GetVendor() Vendor {
+	if x != nil && x.Vendor != nil {
+		return *x.Vendor
+	}
+	return Vendor_ALIYUN
+}
+
+func (x *ExpiringResourcesRequest) GetType() Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return Type_HOST
+}
+
+func (x *ExpiringResourcesRequest) GetWithTags() bool {
+	if x != nil {
+		return x.WithTags
+	}
+	return false
+}
+
+func (x *ExpiringResourcesRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+// 资源的一次变更, 快照为扁平化的字段, 特有属性以describe.开头, 标签以tag.开头
+type Revision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 变更Id
+	// @gotags: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// 资源Id
+	// @gotags: json:"resource_id"
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id"`
+	// 变更时间
+	// @gotags: json:"create_at"
+	CreateAt int64 `protobuf:"varint,3,opt,name=create_at,json=createAt,proto3" json:"create_at"`
+	// 变更人, 同步任务为task:<任务Id>
+	// @gotags: json:"actor"
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor"`
+	// 变更类型
+	// @gotags: json:"action"
+	Action RevisionAction `protobuf:"varint,5,opt,name=action,proto3,enum=opengoats.cmdb.resource.RevisionAction" json:"action"`
+	// 变化的字段
+	// @gotags: json:"changed_fields"
+	ChangedFields []string `protobuf:"bytes,6,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields"`
+	// 变更前的快照, 新建时为空
+	// @gotags: json:"before,omitempty"
+	Before map[string]string `protobuf:"bytes,7,rep,name=before,proto3" json:"before,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// 变更后的快照
+	// @gotags: json:"after,omitempty"
+	After map[string]string `protobuf:"bytes,8,rep,name=after,proto3" json:"after,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Revision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Revision) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *Revision) GetCreateAt() int64 {
+	if x != nil {
+		return x.CreateAt
+	}
+	return 0
+}
+
+func (x *Revision) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *Revision) GetAction() RevisionAction {
+	if x != nil {
+		return x.Action
+	}
+	return RevisionAction_CREATE
+}
+
+func (x *Revision) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *Revision) GetBefore() map[string]string {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *Revision) GetAfter() map[string]string {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type ListResourceRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分页参数
+	// @gotags: json:"page"
+	Page *request.PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page"`
+	// 资源Id
+	// @gotags: json:"resource_id" validate:"required"
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id" validate:"required"`
+	// 只返回变更了该字段的记录, 比如 private_ip, tag.app
+	// @gotags: json:"field"
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field"`
+	// 是否返回变更前后的快照
+	// @gotags: json:"with_snapshot"
+	WithSnapshot bool `protobuf:"varint,4,opt,name=with_snapshot,json=withSnapshot,proto3" json:"with_snapshot"`
+}
+
+func (x *ListResourceRevisionsRequest) Reset() {
+	*x = ListResourceRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResourceRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourceRevisionsRequest) ProtoMessage() {}
+
+func (x *ListResourceRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourceRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListResourceRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListResourceRevisionsRequest) GetPage() *request.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListResourceRevisionsRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ListResourceRevisionsRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ListResourceRevisionsRequest) GetWithSnapshot() bool {
+	if x != nil {
+		return x.WithSnapshot
+	}
+	return false
+}
+
+type RevisionSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	// @gotags: json:"items"
+	Items []*Revision `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+}
+
+func (x *RevisionSet) Reset() {
+	*x = RevisionSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevisionSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionSet) ProtoMessage() {}
+
+func (x *RevisionSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionSet.ProtoReflect.Descriptor instead.
+func (*RevisionSet) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevisionSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RevisionSet) GetItems() []*Revision {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DiffResourceRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 对比的变更Id, 对比两次变更后的快照
+	// @gotags: json:"a" validate:"required"
+	A string `protobuf:"bytes,1,opt,name=a,proto3" json:"a" validate:"required"`
+	// @gotags: json:"b" validate:"required"
+	B string `protobuf:"bytes,2,opt,name=b,proto3" json:"b" validate:"required"`
+}
+
+func (x *DiffResourceRevisionsRequest) Reset() {
+	*x = DiffResourceRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffResourceRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffResourceRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffResourceRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffResourceRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffResourceRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DiffResourceRevisionsRequest) GetA() string {
+	if x != nil {
+		return x.A
+	}
+	return ""
+}
+
+func (x *DiffResourceRevisionsRequest) GetB() string {
+	if x != nil {
+		return x.B
+	}
+	return ""
+}
+
+type FieldDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 字段
+	// @gotags: json:"field"
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	// 变化方式
+	// @gotags: json:"operation"
+	Operation DiffOperation `protobuf:"varint,2,opt,name=operation,proto3,enum=opengoats.cmdb.resource.DiffOperation" json:"operation"`
+	// 变化前的值
+	// @gotags: json:"before"
+	Before string `protobuf:"bytes,3,opt,name=before,proto3" json:"before"`
+	// 变化后的值
+	// @gotags: json:"after"
+	After string `protobuf:"bytes,4,opt,name=after,proto3" json:"after"`
+}
+
+func (x *FieldDiff) Reset() {
+	*x = FieldDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDiff) ProtoMessage() {}
+
+func (x *FieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDiff.ProtoReflect.Descriptor instead.
+func (*FieldDiff) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FieldDiff) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetOperation() DiffOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return DiffOperation_ADDED
+}
+
+func (x *FieldDiff) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type RevisionDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源Id
+	// @gotags: json:"resource_id"
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id"`
+	// 对比的变更
+	// @gotags: json:"a"
+	A *Revision `protobuf:"bytes,2,opt,name=a,proto3" json:"a"`
+	// @gotags: json:"b"
+	B *Revision `protobuf:"bytes,3,opt,name=b,proto3" json:"b"`
+	// 字段级别的差异
+	// @gotags: json:"items"
+	Items []*FieldDiff `protobuf:"bytes,4,rep,name=items,proto3" json:"items"`
+}
+
+func (x *RevisionDiff) Reset() {
+	*x = RevisionDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevisionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionDiff) ProtoMessage() {}
+
+func (x *RevisionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionDiff.ProtoReflect.Descriptor instead.
+func (*RevisionDiff) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevisionDiff) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *RevisionDiff) GetA() *Revision {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *RevisionDiff) GetB() *Revision {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *RevisionDiff) GetItems() []*FieldDiff {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 完整同步一个范围(凭证, 地域, 资源类型)后, 释放本次没有同步到的资源
+// 仅供同步任务内部使用(resource.Service.ReleaseResources), 不通过GRPC和HTTP暴露
+type ReleaseResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 同步使用的凭证
+	// @gotags: json:"secret_id" validate:"required"
+	SecretId string `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id" validate:"required"`
+	// 同步的地域
+	// @gotags: json:"region" validate:"required"
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region" validate:"required"`
+	// 同步的资源类型
+	// @gotags: json:"resource_type"
+	ResourceType Type `protobuf:"varint,3,opt,name=resource_type,json=resourceType,proto3,enum=opengoats.cmdb.resource.Type" json:"resource_type"`
+	// 同步开始时间, 同步时间早于该时间的资源被释放
+	// @gotags: json:"sync_before" validate:"required"
+	SyncBefore int64 `protobuf:"varint,4,opt,name=sync_before,json=syncBefore,proto3" json:"sync_before" validate:"required"`
+	// 释放人, 同步任务为任务Id
+	// @gotags: json:"delete_by"
+	DeleteBy string `protobuf:"bytes,5,opt,name=delete_by,json=deleteBy,proto3" json:"delete_by"`
+}
+
+func (x *ReleaseResourcesRequest) Reset() {
+	*x = ReleaseResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseResourcesRequest) ProtoMessage() {}
+
+func (x *ReleaseResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReleaseResourcesRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *ReleaseResourcesRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ReleaseResourcesRequest) GetResourceType() Type {
+	if x != nil {
+		return x.ResourceType
+	}
+	return Type_HOST
+}
+
+func (x *ReleaseResourcesRequest) GetSyncBefore() int64 {
+	if x != nil {
+		return x.SyncBefore
+	}
+	return 0
+}
+
+func (x *ReleaseResourcesRequest) GetDeleteBy() string {
+	if x != nil {
+		return x.DeleteBy
+	}
+	return ""
+}
+
+type ReleaseResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 释放的资源数量
+	// @gotags: json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	// 释放的资源Id
+	// @gotags: json:"resource_ids"
+	ResourceIds []string `protobuf:"bytes,2,rep,name=resource_ids,json=resourceIds,proto3" json:"resource_ids"`
+}
+
+func (x *ReleaseResult) Reset() {
+	*x = ReleaseResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseResult) ProtoMessage() {}
+
+func (x *ReleaseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseResult.ProtoReflect.Descriptor instead.
+func (*ReleaseResult) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReleaseResult) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReleaseResult) GetResourceIds() []string {
+	if x != nil {
+		return x.ResourceIds
+	}
+	return nil
+}
+
+// 资源的一个IP地址, 用于IP的反向查询
+type ResourceIP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源Id
+	// @gotags: json:"resource_id"
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id"`
+	// IP类型
+	// @gotags: json:"type"
+	Type IPType `protobuf:"varint,2,opt,name=type,proto3,enum=opengoats.cmdb.resource.IPType" json:"type"`
+	// 地址族, 4: IPv4, 6: IPv6
+	// @gotags: json:"family"
+	Family int32 `protobuf:"varint,3,opt,name=family,proto3" json:"family"`
+	// IP地址的标准格式
+	// @gotags: json:"address"
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address"`
+}
+
+func (x *ResourceIP) Reset() {
+	*x = ResourceIP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceIP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceIP) ProtoMessage() {}
+
+func (x *ResourceIP) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceIP.ProtoReflect.Descriptor instead.
+func (*ResourceIP) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResourceIP) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ResourceIP) GetType() IPType {
+	if x != nil {
+		return x.Type
+	}
+	return IPType_PRIVATE
+}
+
+func (x *ResourceIP) GetFamily() int32 {
+	if x != nil {
+		return x.Family
+	}
+	return 0
+}
+
+func (x *ResourceIP) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// 查询拥有该IP的资源
+type LookupByIPRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IP地址
+	// @gotags: json:"ip" validate:"required"
+	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip" validate:"required"`
+	// 只匹配某种类型的IP
+	// @gotags: json:"ip_type"
+	IpType *IPType `protobuf:"varint,2,opt,name=ip_type,json=ipType,proto3,enum=opengoats.cmdb.resource.IPType,oneof" json:"ip_type"`
+	// 是否包含已经释放的资源
+	// @gotags: json:"include_released"
+	IncludeReleased bool `protobuf:"varint,3,opt,name=include_released,json=includeReleased,proto3" json:"include_released"`
+	// 是否返回资源标签
+	// @gotags: json:"with_tags"
+	WithTags bool `protobuf:"varint,4,opt,name=with_tags,json=withTags,proto3" json:"with_tags"`
+	// 调用方, 只返回调用方有权访问的资源
+	// @gotags: json:"caller"
+	Caller *Caller `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller"`
+}
+
+func (x *LookupByIPRequest) Reset() {
+	*x = LookupByIPRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupByIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupByIPRequest) ProtoMessage() {}
+
+func (x *LookupByIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupByIPRequest.ProtoReflect.Descriptor instead.
+func (*LookupByIPRequest) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LookupByIPRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LookupByIPRequest) GetIpType() IPType {
+	if x != nil && x.IpType != nil {
+		return *x.IpType
+	}
+	return IPType_PRIVATE
+}
+
+func (x *LookupByIPRequest) GetIncludeReleased() bool {
+	if x != nil {
+		return x.IncludeReleased
+	}
+	return false
+}
+
+func (x *LookupByIPRequest) GetWithTags() bool {
+	if x != nil {
+		return x.WithTags
+	}
+	return false
+}
+
+func (x *LookupByIPRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+// 按维度统计资源数量, 以及主机的CPU和内存总量
+type AggregateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 过滤条件, 与搜索接口相同, 分页参数和with_tags无效
+	// @gotags: json:"filter"
+	Filter *SearchRequest `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter"`
+	// 分组维度: vendor, region, zone, resource_type, namespace, env, c_status, pay_type 或者 tag:<key>
+	// 最多3个维度, 按顺序分组
+	// @gotags: json:"group_by" validate:"required,max=3"
+	GroupBy []string `protobuf:"bytes,2,rep,name=group_by,json=groupBy,proto3" json:"group_by" validate:"required,max=3"`
+}
+
+func (x *AggregateRequest) Reset() {
+	*x = AggregateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest) ProtoMessage() {}
+
+func (x *AggregateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest.ProtoReflect.Descriptor instead.
+func (*AggregateRequest) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AggregateRequest) GetFilter() *SearchRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+// 一个分组的统计结果
+type AggregateBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分组维度的值, key为维度名称, 资源没有该标签时值为空
+	// @gotags: json:"keys"
+	Keys map[string]string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// 资源数量
+	// @gotags: json:"count"
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	// 主机CPU核数总和
+	// @gotags: json:"cpu"
+	Cpu int64 `protobuf:"varint,3,opt,name=cpu,proto3" json:"cpu"`
+	// 主机内存总和
+	// @gotags: json:"memory"
+	Memory int64 `protobuf:"varint,4,opt,name=memory,proto3" json:"memory"`
+}
+
+func (x *AggregateBucket) Reset() {
+	*x = AggregateBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateBucket) ProtoMessage() {}
+
+func (x *AggregateBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateBucket.ProtoReflect.Descriptor instead.
+func (*AggregateBucket) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AggregateBucket) GetKeys() map[string]string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *AggregateBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateBucket) GetCpu() int64 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *AggregateBucket) GetMemory() int64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+type AggregateResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分组维度
+	// @gotags: json:"group_by"
+	GroupBy []string `protobuf:"bytes,1,rep,name=group_by,json=groupBy,proto3" json:"group_by"`
+	// 按资源数量倒序排列
+	// @gotags: json:"buckets"
+	Buckets []*AggregateBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (x *AggregateResult) Reset() {
+	*x = AggregateResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateResult) ProtoMessage() {}
+
+func (x *AggregateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateResult.ProtoReflect.Descriptor instead.
+func (*AggregateResult) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AggregateResult) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregateResult) GetBuckets() []*AggregateBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+// 资源变更事件, 与资源的变更在同一个事务中写入
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 事件的版本号, 单调递增, 用于断线后继续订阅
+	// @gotags: json:"revision"
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision"`
+	// 事件类型
+	// @gotags: json:"type"
+	Type EventType `protobuf:"varint,2,opt,name=type,proto3,enum=opengoats.cmdb.resource.EventType" json:"type"`
+	// 资源Id
+	// @gotags: json:"resource_id"
+	ResourceId string `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id"`
+	// 资源类型
+	// @gotags: json:"resource_type"
+	ResourceType Type `protobuf:"varint,4,opt,name=resource_type,json=resourceType,proto3,enum=opengoats.cmdb.resource.Type" json:"resource_type"`
+	// 事件时间
+	// @gotags: json:"create_at"
+	CreateAt int64 `protobuf:"varint,5,opt,name=create_at,json=createAt,proto3" json:"create_at"`
+	// 变更人, 同步任务为task:<任务Id>
+	// @gotags: json:"actor"
+	Actor string `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor"`
+	// 资源发送事件时的最新数据, 需要订阅时指定with_resource
+	// @gotags: json:"resource,omitempty"
+	Resource *Resource `protobuf:"bytes,7,opt,name=resource,proto3" json:"resource,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Event) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Event) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_RESOURCE_CREATED
+}
+
+func (x *Event) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *Event) GetResourceType() Type {
+	if x != nil {
+		return x.ResourceType
+	}
+	return Type_HOST
+}
+
+func (x *Event) GetCreateAt() int64 {
+	if x != nil {
+		return x.CreateAt
+	}
+	return 0
+}
+
+func (x *Event) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *Event) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// 订阅资源的变更事件, 只推送调用方有权访问的资源的事件
+type WatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 从该版本号之后的事件开始订阅, 即客户端收到的最后一个事件的版本号, 为0时只订阅新的事件
+	// @gotags: json:"start_revision" validate:"gte=0"
+	StartRevision int64 `protobuf:"varint,1,opt,name=start_revision,json=startRevision,proto3" json:"start_revision" validate:"gte=0"`
+	// 只订阅这些类型的事件, 为空时订阅所有类型
+	// @gotags: json:"types"
+	Types []EventType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=opengoats.cmdb.resource.EventType" json:"types"`
+	// 只订阅该类型资源的事件
+	// @gotags: json:"resource_type"
+	ResourceType *Type `protobuf:"varint,3,opt,name=resource_type,json=resourceType,proto3,enum=opengoats.cmdb.resource.Type,oneof" json:"resource_type"`
+	// 是否在事件中携带资源的最新数据和标签
+	// @gotags: json:"with_resource"
+	WithResource bool `protobuf:"varint,4,opt,name=with_resource,json=withResource,proto3" json:"with_resource"`
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_resource_pb_resource_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_resource_pb_resource_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_apps_resource_pb_resource_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WatchRequest) GetStartRevision() int64 {
+	if x != nil {
+		return x.StartRevision
+	}
+	return 0
+}
+
+func (x *WatchRequest) GetTypes() []EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *WatchRequest) GetResourceType() Type {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return Type_HOST
+}
+
+func (x *WatchRequest) GetWithResource() bool {
+	if x != nil {
+		return x.WithResource
+	}
+	return false
+}
+
+var File_apps_resource_pb_resource_proto protoreflect.FileDescriptor
+
 var file_apps_resource_pb_resource_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f,
 	0x70, 0x62, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
@@ -1407,7 +3509,7 @@ var file_apps_resource_pb_resource_proto_rawDesc = []byte{
 	0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x2c, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73,
 	0x2f, 0x67, 0x6f, 0x61, 0x74, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x61,
-	0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x09, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x09, 0x0a, 0x08, 0x52, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a,
@@ -1481,145 +3583,524 @@ var file_apps_resource_pb_resource_proto_rawDesc = []byte{
 	0x6c, 0x69, 0x63, 0x49, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
 	0x5f, 0x69, 0x70, 0x18, 0x22, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x76, 0x61,
 	0x74, 0x65, 0x49, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22,
-	0x46, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
-	0x17, 0x0a, 0x07, 0x74, 0x61, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x74, 0x61, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x67, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xa4, 0x04, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12,
-	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
-	0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73,
-	0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54,
-	0x61, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x63,
-	0x6f, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x43, 0x6f, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x04, 0x6d, 0x65, 0x74,
-	0x61, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f,
+	0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x24, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x0c, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x67,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x67, 0x4b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0x9e, 0x01, 0x0a, 0x06, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67,
+	0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xa4, 0x04, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x79, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x62,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x79, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63,
+	0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xab, 0x06, 0x0a, 0x0d, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x67, 0x6f, 0x61, 0x74, 0x2e, 0x70, 0x61, 0x67, 0x65, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x46, 0x0a, 0x0a, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x09, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x3c, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d,
+	0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x48, 0x01, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
+	0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x48,
+	0x02, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
+	0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x77, 0x69, 0x74, 0x68, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x78, 0x61, 0x63,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61,
+	0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69,
+	0x64, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x69, 0x70, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x69, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x69, 0x70, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x50, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x06, 0x69,
+	0x70, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x69, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x53, 0x0a, 0x0b, 0x54, 0x61, 0x67, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x0b,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
+	0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x48, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x22, 0x52, 0x0a, 0x06, 0x54, 0x61, 0x67, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
+	0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xf7, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f,
 	0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x54, 0x61, 0x67, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x04, 0x6d, 0x65, 0x74, 0x61, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbd,
-	0x04, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x34, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x67, 0x6f, 0x61, 0x74, 0x2e,
-	0x70, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c,
-	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x46,
-	0x0a, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63,
-	0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x61,
-	0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4d,
-	0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61,
+	0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x63,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x06, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xf2, 0x01, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3d, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d,
+	0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4e,
+	0x0a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
+	0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x1a, 0x3b, 0x0a, 0x0d, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x4e, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x61, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f,
+	0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0xa6, 0x02, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e,
+	0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x3b,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
+	0x32, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x61, 0x73, 0x68, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a,
+	0x0d, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x39, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e,
+	0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x61,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0xd8, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4a, 0x0a, 0x0d, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d,
+	0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61,
 	0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x48, 0x01, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74,
-	0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x48, 0x02, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
-	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74,
-	0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x54, 0x61, 0x67, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78,
-	0x61, 0x63, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x65, 0x78, 0x61, 0x63, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76,
-	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x53,
-	0x0a, 0x0b, 0x54, 0x61, 0x67, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
-	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9e, 0x03, 0x0a, 0x18, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74,
+	0x73, 0x2e, 0x67, 0x6f, 0x61, 0x74, 0x2e, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x3c, 0x0a, 0x06, 0x76,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06,
+	0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f,
 	0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x22, 0x34, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x06, 0x54, 0x61, 0x67, 0x53, 0x65,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61,
+	0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x54, 0x61, 0x67, 0x73, 0x12, 0x37,
+	0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52,
+	0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd6, 0x03, 0x0a, 0x08,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73,
+	0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x1a,
+	0x39, 0x0a, 0x0b, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e,
+	0x67, 0x6f, 0x61, 0x74, 0x2e, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x5c, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x0a, 0x1c, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01,
+	0x62, 0x22, 0x95, 0x01, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67,
+	0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xcb, 0x01, 0x0a, 0x0c, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x01, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61,
 	0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x54, 0x61, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x10,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x3d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
-	0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x30, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x01, 0x61, 0x12, 0x2f, 0x0a, 0x01,
+	0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f,
+	0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x01, 0x62, 0x12, 0x38, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x22, 0x48, 0x0a, 0x0d, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x50, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63,
+	0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x50, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x11,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x42, 0x79, 0x49, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x70, 0x12, 0x3d, 0x0a, 0x07, 0x69, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63,
+	0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x49, 0x50, 0x54,
+	0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x06, 0x69, 0x70, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77,
+	0x69, 0x74, 0x68, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x77, 0x69, 0x74, 0x68, 0x54, 0x61, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67,
+	0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x6d, 0x0a,
+	0x10, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d,
+	0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x22, 0xd2, 0x01, 0x0a,
+	0x0f, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x46, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x70, 0x75,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x1a, 0x37, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x70, 0x0a, 0x0f, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12,
+	0x42, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
+	0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f,
+	0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x38, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d,
+	0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x36, 0x0a, 0x06, 0x56, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x4c, 0x49, 0x59, 0x55, 0x4e, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x4e, 0x43, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x48, 0x55, 0x41, 0x57, 0x45, 0x49, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x44, 0x43,
+	0x10, 0x03, 0x2a, 0x23, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f,
+	0x53, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x44, 0x53, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x42, 0x49, 0x4c, 0x4c, 0x10, 0x63, 0x2a, 0x25, 0x0a, 0x09, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x4e, 0x4f, 0x50, 0x4f, 0x4c, 0x59, 0x10, 0x01, 0x2a, 0x2a,
+	0x0a, 0x07, 0x54, 0x61, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45,
+	0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x48, 0x49, 0x52, 0x44, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x02, 0x2a, 0x23, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44,
+	0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x2a,
+	0x41, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a,
+	0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x03, 0x2a, 0x38, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x47, 0x10, 0x02, 0x2a, 0x35, 0x0a, 0x0d,
+	0x44, 0x69, 0x66, 0x66, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a,
+	0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f,
+	0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x02, 0x2a, 0x21, 0x0a, 0x06, 0x49, 0x50, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55,
+	0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x2a, 0x67, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x54, 0x41, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x32,
+	0xf9, 0x09, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74,
+	0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
 	0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x2a, 0x36, 0x0a, 0x06, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x06, 0x41,
-	0x4c, 0x49, 0x59, 0x55, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x4e, 0x43, 0x45,
-	0x4e, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x55, 0x41, 0x57, 0x45, 0x49, 0x10, 0x02,
-	0x12, 0x07, 0x0a, 0x03, 0x49, 0x44, 0x43, 0x10, 0x03, 0x2a, 0x23, 0x0a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x52,
-	0x44, 0x53, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x49, 0x4c, 0x4c, 0x10, 0x63, 0x2a, 0x25,
-	0x0a, 0x09, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53,
-	0x48, 0x41, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x4e, 0x4f, 0x50,
-	0x4f, 0x4c, 0x59, 0x10, 0x01, 0x2a, 0x2a, 0x0a, 0x07, 0x54, 0x61, 0x67, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x48,
-	0x49, 0x52, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10,
-	0x02, 0x2a, 0x23, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45,
-	0x4d, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x32, 0x93, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x26, 0x2e, 0x6f,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x12, 0x5b, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e,
+	0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x30, 0x01,
+	0x12, 0x55, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x67, 0x12, 0x28, 0x2e, 0x6f,
 	0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61,
+	0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x54, 0x61, 0x67, 0x53, 0x65, 0x74, 0x12, 0x59, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x61, 0x67, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73,
+	0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x51, 0x0a, 0x04, 0x53, 0x61, 0x76, 0x65, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
+	0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x5e, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x61,
+	0x76, 0x65, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63,
+	0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x53, 0x65, 0x74, 0x12, 0x65, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67,
+	0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x11,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d,
+	0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73,
 	0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x55, 0x0a, 0x08, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x61, 0x67, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61,
-	0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
-	0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x53, 0x65,
-	0x74, 0x12, 0x59, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x29,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x74, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x35, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e,
+	0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74,
+	0x12, 0x75, 0x0a, 0x15, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64,
+	0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x12, 0x5e, 0x0a, 0x0a, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x42, 0x79, 0x49, 0x50, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74,
+	0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x42, 0x79, 0x49, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d,
+	0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x60, 0x0a, 0x09, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73,
+	0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x50, 0x0a, 0x05, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63,
+	0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
 	0x67, 0x6f, 0x61, 0x74, 0x73, 0x2e, 0x63, 0x6d, 0x64, 0x62, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x29, 0x5a, 0x27,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x67,
-	0x6f, 0x61, 0x74, 0x73, 0x2f, 0x63, 0x6d, 0x64, 0x62, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x63, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x29, 0x5a, 0x27, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x67, 0x6f,
+	0x61, 0x74, 0x73, 0x2f, 0x63, 0x6d, 0x64, 0x62, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1634,54 +4115,143 @@ func file_apps_resource_pb_resource_proto_rawDescGZIP() []byte {
 	return file_apps_resource_pb_resource_proto_rawDescData
 }
 
-var file_apps_resource_pb_resource_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_apps_resource_pb_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_apps_resource_pb_resource_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_apps_resource_pb_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_apps_resource_pb_resource_proto_goTypes = []interface{}{
-	(Vendor)(0),                 // 0: opengoats.cmdb.resource.Vendor
-	(Type)(0),                   // 1: opengoats.cmdb.resource.Type
-	(UsageMode)(0),              // 2: opengoats.cmdb.resource.UsageMode
-	(TagType)(0),                // 3: opengoats.cmdb.resource.TagType
-	(UpdateAction)(0),           // 4: opengoats.cmdb.resource.UpdateAction
-	(*Resource)(nil),            // 5: opengoats.cmdb.resource.Resource
-	(*SharedPolicy)(nil),        // 6: opengoats.cmdb.resource.SharedPolicy
-	(*Tag)(nil),                 // 7: opengoats.cmdb.resource.Tag
-	(*SearchRequest)(nil),       // 8: opengoats.cmdb.resource.SearchRequest
-	(*TagSelector)(nil),         // 9: opengoats.cmdb.resource.TagSelector
-	(*ResourceSet)(nil),         // 10: opengoats.cmdb.resource.ResourceSet
-	(*QueryTagRequest)(nil),     // 11: opengoats.cmdb.resource.QueryTagRequest
-	(*TagSet)(nil),              // 12: opengoats.cmdb.resource.TagSet
-	(*UpdateTagRequest)(nil),    // 13: opengoats.cmdb.resource.UpdateTagRequest
-	nil,                         // 14: opengoats.cmdb.resource.Tag.MetaEntry
-	(*request.PageRequest)(nil), // 15: opengoats.goat.page.PageRequest
+	(Vendor)(0),                          // 0: opengoats.cmdb.resource.Vendor
+	(Type)(0),                            // 1: opengoats.cmdb.resource.Type
+	(UsageMode)(0),                       // 2: opengoats.cmdb.resource.UsageMode
+	(TagType)(0),                         // 3: opengoats.cmdb.resource.TagType
+	(UpdateAction)(0),                    // 4: opengoats.cmdb.resource.UpdateAction
+	(SaveStatus)(0),                      // 5: opengoats.cmdb.resource.SaveStatus
+	(RevisionAction)(0),                  // 6: opengoats.cmdb.resource.RevisionAction
+	(DiffOperation)(0),                   // 7: opengoats.cmdb.resource.DiffOperation
+	(IPType)(0),                          // 8: opengoats.cmdb.resource.IPType
+	(EventType)(0),                       // 9: opengoats.cmdb.resource.EventType
+	(*Resource)(nil),                     // 10: opengoats.cmdb.resource.Resource
+	(*SharedPolicy)(nil),                 // 11: opengoats.cmdb.resource.SharedPolicy
+	(*Caller)(nil),                       // 12: opengoats.cmdb.resource.Caller
+	(*Tag)(nil),                          // 13: opengoats.cmdb.resource.Tag
+	(*SearchRequest)(nil),                // 14: opengoats.cmdb.resource.SearchRequest
+	(*TagSelector)(nil),                  // 15: opengoats.cmdb.resource.TagSelector
+	(*ResourceSet)(nil),                  // 16: opengoats.cmdb.resource.ResourceSet
+	(*QueryTagRequest)(nil),              // 17: opengoats.cmdb.resource.QueryTagRequest
+	(*TagSet)(nil),                       // 18: opengoats.cmdb.resource.TagSet
+	(*UpdateTagRequest)(nil),             // 19: opengoats.cmdb.resource.UpdateTagRequest
+	(*SaveRequest)(nil),                  // 20: opengoats.cmdb.resource.SaveRequest
+	(*BatchSaveRequest)(nil),             // 21: opengoats.cmdb.resource.BatchSaveRequest
+	(*SaveResult)(nil),                   // 22: opengoats.cmdb.resource.SaveResult
+	(*SaveResultSet)(nil),                // 23: opengoats.cmdb.resource.SaveResultSet
+	(*SetSharedPolicyRequest)(nil),       // 24: opengoats.cmdb.resource.SetSharedPolicyRequest
+	(*ExpiringResourcesRequest)(nil),     // 25: opengoats.cmdb.resource.ExpiringResourcesRequest
+	(*Revision)(nil),                     // 26: opengoats.cmdb.resource.Revision
+	(*ListResourceRevisionsRequest)(nil), // 27: opengoats.cmdb.resource.ListResourceRevisionsRequest
+	(*RevisionSet)(nil),                  // 28: opengoats.cmdb.resource.RevisionSet
+	(*DiffResourceRevisionsRequest)(nil), // 29: opengoats.cmdb.resource.DiffResourceRevisionsRequest
+	(*FieldDiff)(nil),                    // 30: opengoats.cmdb.resource.FieldDiff
+	(*RevisionDiff)(nil),                 // 31: opengoats.cmdb.resource.RevisionDiff
+	(*ReleaseResourcesRequest)(nil),      // 32: opengoats.cmdb.resource.ReleaseResourcesRequest
+	(*ReleaseResult)(nil),                // 33: opengoats.cmdb.resource.ReleaseResult
+	(*ResourceIP)(nil),                   // 34: opengoats.cmdb.resource.ResourceIP
+	(*LookupByIPRequest)(nil),            // 35: opengoats.cmdb.resource.LookupByIPRequest
+	(*AggregateRequest)(nil),             // 36: opengoats.cmdb.resource.AggregateRequest
+	(*AggregateBucket)(nil),              // 37: opengoats.cmdb.resource.AggregateBucket
+	(*AggregateResult)(nil),              // 38: opengoats.cmdb.resource.AggregateResult
+	(*Event)(nil),                        // 39: opengoats.cmdb.resource.Event
+	(*WatchRequest)(nil),                 // 40: opengoats.cmdb.resource.WatchRequest
+	nil,                                  // 41: opengoats.cmdb.resource.Caller.TagsEntry
+	nil,                                  // 42: opengoats.cmdb.resource.Tag.MetaEntry
+	nil,                                  // 43: opengoats.cmdb.resource.SaveRequest.DescribeEntry
+	nil,                                  // 44: opengoats.cmdb.resource.Revision.BeforeEntry
+	nil,                                  // 45: opengoats.cmdb.resource.Revision.AfterEntry
+	nil,                                  // 46: opengoats.cmdb.resource.AggregateBucket.KeysEntry
+	(*request.PageRequest)(nil),          // 47: opengoats.goat.page.PageRequest
 }
 var file_apps_resource_pb_resource_proto_depIdxs = []int32{
 	0,  // 0: opengoats.cmdb.resource.Resource.vendor:type_name -> opengoats.cmdb.resource.Vendor
 	1,  // 1: opengoats.cmdb.resource.Resource.resource_type:type_name -> opengoats.cmdb.resource.Type
 	2,  // 2: opengoats.cmdb.resource.Resource.usage_mode:type_name -> opengoats.cmdb.resource.UsageMode
-	6,  // 3: opengoats.cmdb.resource.Resource.shared_policy:type_name -> opengoats.cmdb.resource.SharedPolicy
-	7,  // 4: opengoats.cmdb.resource.Resource.tags:type_name -> opengoats.cmdb.resource.Tag
-	3,  // 5: opengoats.cmdb.resource.Tag.type:type_name -> opengoats.cmdb.resource.TagType
-	14, // 6: opengoats.cmdb.resource.Tag.meta:type_name -> opengoats.cmdb.resource.Tag.MetaEntry
-	15, // 7: opengoats.cmdb.resource.SearchRequest.page:type_name -> opengoats.goat.page.PageRequest
-	2,  // 8: opengoats.cmdb.resource.SearchRequest.usage_mode:type_name -> opengoats.cmdb.resource.UsageMode
-	0,  // 9: opengoats.cmdb.resource.SearchRequest.vendor:type_name -> opengoats.cmdb.resource.Vendor
-	1,  // 10: opengoats.cmdb.resource.SearchRequest.type:type_name -> opengoats.cmdb.resource.Type
-	9,  // 11: opengoats.cmdb.resource.SearchRequest.tags:type_name -> opengoats.cmdb.resource.TagSelector
-	5,  // 12: opengoats.cmdb.resource.ResourceSet.items:type_name -> opengoats.cmdb.resource.Resource
-	7,  // 13: opengoats.cmdb.resource.TagSet.items:type_name -> opengoats.cmdb.resource.Tag
-	4,  // 14: opengoats.cmdb.resource.UpdateTagRequest.action:type_name -> opengoats.cmdb.resource.UpdateAction
-	7,  // 15: opengoats.cmdb.resource.UpdateTagRequest.tags:type_name -> opengoats.cmdb.resource.Tag
-	8,  // 16: opengoats.cmdb.resource.Service.Search:input_type -> opengoats.cmdb.resource.SearchRequest
-	11, // 17: opengoats.cmdb.resource.Service.QueryTag:input_type -> opengoats.cmdb.resource.QueryTagRequest
-	13, // 18: opengoats.cmdb.resource.Service.UpdateTag:input_type -> opengoats.cmdb.resource.UpdateTagRequest
-	10, // 19: opengoats.cmdb.resource.Service.Search:output_type -> opengoats.cmdb.resource.ResourceSet
-	12, // 20: opengoats.cmdb.resource.Service.QueryTag:output_type -> opengoats.cmdb.resource.TagSet
-	5,  // 21: opengoats.cmdb.resource.Service.UpdateTag:output_type -> opengoats.cmdb.resource.Resource
-	19, // [19:22] is the sub-list for method output_type
-	16, // [16:19] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	11, // 3: opengoats.cmdb.resource.Resource.shared_policy:type_name -> opengoats.cmdb.resource.SharedPolicy
+	13, // 4: opengoats.cmdb.resource.Resource.tags:type_name -> opengoats.cmdb.resource.Tag
+	41, // 5: opengoats.cmdb.resource.Caller.tags:type_name -> opengoats.cmdb.resource.Caller.TagsEntry
+	3,  // 6: opengoats.cmdb.resource.Tag.type:type_name -> opengoats.cmdb.resource.TagType
+	42, // 7: opengoats.cmdb.resource.Tag.meta:type_name -> opengoats.cmdb.resource.Tag.MetaEntry
+	47, // 8: opengoats.cmdb.resource.SearchRequest.page:type_name -> opengoats.goat.page.PageRequest
+	2,  // 9: opengoats.cmdb.resource.SearchRequest.usage_mode:type_name -> opengoats.cmdb.resource.UsageMode
+	0,  // 10: opengoats.cmdb.resource.SearchRequest.vendor:type_name -> opengoats.cmdb.resource.Vendor
+	1,  // 11: opengoats.cmdb.resource.SearchRequest.type:type_name -> opengoats.cmdb.resource.Type
+	15, // 12: opengoats.cmdb.resource.SearchRequest.tags:type_name -> opengoats.cmdb.resource.TagSelector
+	12, // 13: opengoats.cmdb.resource.SearchRequest.caller:type_name -> opengoats.cmdb.resource.Caller
+	8,  // 14: opengoats.cmdb.resource.SearchRequest.ip_type:type_name -> opengoats.cmdb.resource.IPType
+	10, // 15: opengoats.cmdb.resource.ResourceSet.items:type_name -> opengoats.cmdb.resource.Resource
+	13, // 16: opengoats.cmdb.resource.TagSet.items:type_name -> opengoats.cmdb.resource.Tag
+	4,  // 17: opengoats.cmdb.resource.UpdateTagRequest.action:type_name -> opengoats.cmdb.resource.UpdateAction
+	13, // 18: opengoats.cmdb.resource.UpdateTagRequest.tags:type_name -> opengoats.cmdb.resource.Tag
+	12, // 19: opengoats.cmdb.resource.UpdateTagRequest.caller:type_name -> opengoats.cmdb.resource.Caller
+	10, // 20: opengoats.cmdb.resource.SaveRequest.resource:type_name -> opengoats.cmdb.resource.Resource
+	43, // 21: opengoats.cmdb.resource.SaveRequest.describe:type_name -> opengoats.cmdb.resource.SaveRequest.DescribeEntry
+	20, // 22: opengoats.cmdb.resource.BatchSaveRequest.items:type_name -> opengoats.cmdb.resource.SaveRequest
+	0,  // 23: opengoats.cmdb.resource.SaveResult.vendor:type_name -> opengoats.cmdb.resource.Vendor
+	5,  // 24: opengoats.cmdb.resource.SaveResult.status:type_name -> opengoats.cmdb.resource.SaveStatus
+	22, // 25: opengoats.cmdb.resource.SaveResultSet.items:type_name -> opengoats.cmdb.resource.SaveResult
+	11, // 26: opengoats.cmdb.resource.SetSharedPolicyRequest.shared_policy:type_name -> opengoats.cmdb.resource.SharedPolicy
+	12, // 27: opengoats.cmdb.resource.SetSharedPolicyRequest.caller:type_name -> opengoats.cmdb.resource.Caller
+	47, // 28: opengoats.cmdb.resource.ExpiringResourcesRequest.page:type_name -> opengoats.goat.page.PageRequest
+	0,  // 29: opengoats.cmdb.resource.ExpiringResourcesRequest.vendor:type_name -> opengoats.cmdb.resource.Vendor
+	1,  // 30: opengoats.cmdb.resource.ExpiringResourcesRequest.type:type_name -> opengoats.cmdb.resource.Type
+	12, // 31: opengoats.cmdb.resource.ExpiringResourcesRequest.caller:type_name -> opengoats.cmdb.resource.Caller
+	6,  // 32: opengoats.cmdb.resource.Revision.action:type_name -> opengoats.cmdb.resource.RevisionAction
+	44, // 33: opengoats.cmdb.resource.Revision.before:type_name -> opengoats.cmdb.resource.Revision.BeforeEntry
+	45, // 34: opengoats.cmdb.resource.Revision.after:type_name -> opengoats.cmdb.resource.Revision.AfterEntry
+	47, // 35: opengoats.cmdb.resource.ListResourceRevisionsRequest.page:type_name -> opengoats.goat.page.PageRequest
+	26, // 36: opengoats.cmdb.resource.RevisionSet.items:type_name -> opengoats.cmdb.resource.Revision
+	7,  // 37: opengoats.cmdb.resource.FieldDiff.operation:type_name -> opengoats.cmdb.resource.DiffOperation
+	26, // 38: opengoats.cmdb.resource.RevisionDiff.a:type_name -> opengoats.cmdb.resource.Revision
+	26, // 39: opengoats.cmdb.resource.RevisionDiff.b:type_name -> opengoats.cmdb.resource.Revision
+	30, // 40: opengoats.cmdb.resource.RevisionDiff.items:type_name -> opengoats.cmdb.resource.FieldDiff
+	1,  // 41: opengoats.cmdb.resource.ReleaseResourcesRequest.resource_type:type_name -> opengoats.cmdb.resource.Type
+	8,  // 42: opengoats.cmdb.resource.ResourceIP.type:type_name -> opengoats.cmdb.resource.IPType
+	8,  // 43: opengoats.cmdb.resource.LookupByIPRequest.ip_type:type_name -> opengoats.cmdb.resource.IPType
+	12, // 44: opengoats.cmdb.resource.LookupByIPRequest.caller:type_name -> opengoats.cmdb.resource.Caller
+	14, // 45: opengoats.cmdb.resource.AggregateRequest.filter:type_name -> opengoats.cmdb.resource.SearchRequest
+	46, // 46: opengoats.cmdb.resource.AggregateBucket.keys:type_name -> opengoats.cmdb.resource.AggregateBucket.KeysEntry
+	37, // 47: opengoats.cmdb.resource.AggregateResult.buckets:type_name -> opengoats.cmdb.resource.AggregateBucket
+	9,  // 48: opengoats.cmdb.resource.Event.type:type_name -> opengoats.cmdb.resource.EventType
+	1,  // 49: opengoats.cmdb.resource.Event.resource_type:type_name -> opengoats.cmdb.resource.Type
+	10, // 50: opengoats.cmdb.resource.Event.resource:type_name -> opengoats.cmdb.resource.Resource
+	9,  // 51: opengoats.cmdb.resource.WatchRequest.types:type_name -> opengoats.cmdb.resource.EventType
+	1,  // 52: opengoats.cmdb.resource.WatchRequest.resource_type:type_name -> opengoats.cmdb.resource.Type
+	14, // 53: opengoats.cmdb.resource.Service.Search:input_type -> opengoats.cmdb.resource.SearchRequest
+	14, // 54: opengoats.cmdb.resource.Service.StreamSearch:input_type -> opengoats.cmdb.resource.SearchRequest
+	17, // 55: opengoats.cmdb.resource.Service.QueryTag:input_type -> opengoats.cmdb.resource.QueryTagRequest
+	19, // 56: opengoats.cmdb.resource.Service.UpdateTag:input_type -> opengoats.cmdb.resource.UpdateTagRequest
+	20, // 57: opengoats.cmdb.resource.Service.Save:input_type -> opengoats.cmdb.resource.SaveRequest
+	21, // 58: opengoats.cmdb.resource.Service.BatchSave:input_type -> opengoats.cmdb.resource.BatchSaveRequest
+	24, // 59: opengoats.cmdb.resource.Service.SetSharedPolicy:input_type -> opengoats.cmdb.resource.SetSharedPolicyRequest
+	25, // 60: opengoats.cmdb.resource.Service.ExpiringResources:input_type -> opengoats.cmdb.resource.ExpiringResourcesRequest
+	27, // 61: opengoats.cmdb.resource.Service.ListResourceRevisions:input_type -> opengoats.cmdb.resource.ListResourceRevisionsRequest
+	29, // 62: opengoats.cmdb.resource.Service.DiffResourceRevisions:input_type -> opengoats.cmdb.resource.DiffResourceRevisionsRequest
+	35, // 63: opengoats.cmdb.resource.Service.LookupByIP:input_type -> opengoats.cmdb.resource.LookupByIPRequest
+	36, // 64: opengoats.cmdb.resource.Service.Aggregate:input_type -> opengoats.cmdb.resource.AggregateRequest
+	40, // 65: opengoats.cmdb.resource.Service.Watch:input_type -> opengoats.cmdb.resource.WatchRequest
+	16, // 66: opengoats.cmdb.resource.Service.Search:output_type -> opengoats.cmdb.resource.ResourceSet
+	10, // 67: opengoats.cmdb.resource.Service.StreamSearch:output_type -> opengoats.cmdb.resource.Resource
+	18, // 68: opengoats.cmdb.resource.Service.QueryTag:output_type -> opengoats.cmdb.resource.TagSet
+	10, // 69: opengoats.cmdb.resource.Service.UpdateTag:output_type -> opengoats.cmdb.resource.Resource
+	22, // 70: opengoats.cmdb.resource.Service.Save:output_type -> opengoats.cmdb.resource.SaveResult
+	23, // 71: opengoats.cmdb.resource.Service.BatchSave:output_type -> opengoats.cmdb.resource.SaveResultSet
+	10, // 72: opengoats.cmdb.resource.Service.SetSharedPolicy:output_type -> opengoats.cmdb.resource.Resource
+	16, // 73: opengoats.cmdb.resource.Service.ExpiringResources:output_type -> opengoats.cmdb.resource.ResourceSet
+	28, // 74: opengoats.cmdb.resource.Service.ListResourceRevisions:output_type -> opengoats.cmdb.resource.RevisionSet
+	31, // 75: opengoats.cmdb.resource.Service.DiffResourceRevisions:output_type -> opengoats.cmdb.resource.RevisionDiff
+	16, // 76: opengoats.cmdb.resource.Service.LookupByIP:output_type -> opengoats.cmdb.resource.ResourceSet
+	38, // 77: opengoats.cmdb.resource.Service.Aggregate:output_type -> opengoats.cmdb.resource.AggregateResult
+	39, // 78: opengoats.cmdb.resource.Service.Watch:output_type -> opengoats.cmdb.resource.Event
+	66, // [66:79] is the sub-list for method output_type
+	53, // [53:66] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_apps_resource_pb_resource_proto_init() }
@@ -1715,7 +4285,7 @@ func file_apps_resource_pb_resource_proto_init() {
 			}
 		}
 		file_apps_resource_pb_resource_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tag); i {
+			switch v := v.(*Caller); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1727,7 +4297,7 @@ func file_apps_resource_pb_resource_proto_init() {
 			}
 		}
 		file_apps_resource_pb_resource_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest); i {
+			switch v := v.(*Tag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1739,7 +4309,7 @@ func file_apps_resource_pb_resource_proto_init() {
 			}
 		}
 		file_apps_resource_pb_resource_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TagSelector); i {
+			switch v := v.(*SearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1751,7 +4321,7 @@ func file_apps_resource_pb_resource_proto_init() {
 			}
 		}
 		file_apps_resource_pb_resource_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceSet); i {
+			switch v := v.(*TagSelector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1763,7 +4333,7 @@ func file_apps_resource_pb_resource_proto_init() {
 			}
 		}
 		file_apps_resource_pb_resource_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTagRequest); i {
+			switch v := v.(*ResourceSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1775,7 +4345,7 @@ func file_apps_resource_pb_resource_proto_init() {
 			}
 		}
 		file_apps_resource_pb_resource_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TagSet); i {
+			switch v := v.(*QueryTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1787,6 +4357,18 @@ func file_apps_resource_pb_resource_proto_init() {
 			}
 		}
 		file_apps_resource_pb_resource_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateTagRequest); i {
 			case 0:
 				return &v.state
@@ -1798,15 +4380,270 @@ func file_apps_resource_pb_resource_proto_init() {
 				return nil
 			}
 		}
+		file_apps_resource_pb_resource_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveResultSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSharedPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpiringResourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Revision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResourceRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevisionSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffResourceRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevisionDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseResourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceIP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupByIPRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_resource_pb_resource_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_apps_resource_pb_resource_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_apps_resource_pb_resource_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_apps_resource_pb_resource_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_apps_resource_pb_resource_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_apps_resource_pb_resource_proto_msgTypes[30].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apps_resource_pb_resource_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   10,
+			NumEnums:      10,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -233,3 +233,228 @@ func (t *UpdateAction) UnmarshalJSON(b []byte) error {
 	*t = ins
 	return nil
 }
+
+// ParseSaveStatusFromString Parse SaveStatus from string
+func ParseSaveStatusFromString(str string) (SaveStatus, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := SaveStatus_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown SaveStatus: %s", str)
+	}
+
+	return SaveStatus(v), nil
+}
+
+// Equal type compare
+func (t SaveStatus) Equal(target SaveStatus) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t SaveStatus) IsIn(targets ...SaveStatus) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t SaveStatus) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *SaveStatus) UnmarshalJSON(b []byte) error {
+	ins, err := ParseSaveStatusFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseRevisionActionFromString Parse RevisionAction from string
+func ParseRevisionActionFromString(str string) (RevisionAction, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := RevisionAction_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown RevisionAction: %s", str)
+	}
+
+	return RevisionAction(v), nil
+}
+
+// Equal type compare
+func (t RevisionAction) Equal(target RevisionAction) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t RevisionAction) IsIn(targets ...RevisionAction) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t RevisionAction) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *RevisionAction) UnmarshalJSON(b []byte) error {
+	ins, err := ParseRevisionActionFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseDiffOperationFromString Parse DiffOperation from string
+func ParseDiffOperationFromString(str string) (DiffOperation, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := DiffOperation_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown DiffOperation: %s", str)
+	}
+
+	return DiffOperation(v), nil
+}
+
+// Equal type compare
+func (t DiffOperation) Equal(target DiffOperation) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t DiffOperation) IsIn(targets ...DiffOperation) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t DiffOperation) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *DiffOperation) UnmarshalJSON(b []byte) error {
+	ins, err := ParseDiffOperationFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseIPTypeFromString Parse IPType from string
+func ParseIPTypeFromString(str string) (IPType, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := IPType_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown IPType: %s", str)
+	}
+
+	return IPType(v), nil
+}
+
+// Equal type compare
+func (t IPType) Equal(target IPType) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t IPType) IsIn(targets ...IPType) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t IPType) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *IPType) UnmarshalJSON(b []byte) error {
+	ins, err := ParseIPTypeFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseEventTypeFromString Parse EventType from string
+func ParseEventTypeFromString(str string) (EventType, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := EventType_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown EventType: %s", str)
+	}
+
+	return EventType(v), nil
+}
+
+// Equal type compare
+func (t EventType) Equal(target EventType) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t EventType) IsIn(targets ...EventType) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t EventType) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *EventType) UnmarshalJSON(b []byte) error {
+	ins, err := ParseEventTypeFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
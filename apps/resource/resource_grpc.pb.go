@@ -19,9 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Search_FullMethodName    = "/opengoats.cmdb.resource.Service/Search"
-	Service_QueryTag_FullMethodName  = "/opengoats.cmdb.resource.Service/QueryTag"
-	Service_UpdateTag_FullMethodName = "/opengoats.cmdb.resource.Service/UpdateTag"
+	Service_Search_FullMethodName                = "/opengoats.cmdb.resource.Service/Search"
+	Service_StreamSearch_FullMethodName          = "/opengoats.cmdb.resource.Service/StreamSearch"
+	Service_QueryTag_FullMethodName              = "/opengoats.cmdb.resource.Service/QueryTag"
+	Service_UpdateTag_FullMethodName             = "/opengoats.cmdb.resource.Service/UpdateTag"
+	Service_Save_FullMethodName                  = "/opengoats.cmdb.resource.Service/Save"
+	Service_BatchSave_FullMethodName             = "/opengoats.cmdb.resource.Service/BatchSave"
+	Service_SetSharedPolicy_FullMethodName       = "/opengoats.cmdb.resource.Service/SetSharedPolicy"
+	Service_ExpiringResources_FullMethodName     = "/opengoats.cmdb.resource.Service/ExpiringResources"
+	Service_ListResourceRevisions_FullMethodName = "/opengoats.cmdb.resource.Service/ListResourceRevisions"
+	Service_DiffResourceRevisions_FullMethodName = "/opengoats.cmdb.resource.Service/DiffResourceRevisions"
+	Service_LookupByIP_FullMethodName            = "/opengoats.cmdb.resource.Service/LookupByIP"
+	Service_Aggregate_FullMethodName             = "/opengoats.cmdb.resource.Service/Aggregate"
+	Service_Watch_FullMethodName                 = "/opengoats.cmdb.resource.Service/Watch"
 )
 
 // ServiceClient is the client API for Service service.
@@ -29,8 +39,18 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ServiceClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*ResourceSet, error)
+	StreamSearch(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Service_StreamSearchClient, error)
 	QueryTag(ctx context.Context, in *QueryTagRequest, opts ...grpc.CallOption) (*TagSet, error)
 	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*Resource, error)
+	Save(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveResult, error)
+	BatchSave(ctx context.Context, in *BatchSaveRequest, opts ...grpc.CallOption) (*SaveResultSet, error)
+	SetSharedPolicy(ctx context.Context, in *SetSharedPolicyRequest, opts ...grpc.CallOption) (*Resource, error)
+	ExpiringResources(ctx context.Context, in *ExpiringResourcesRequest, opts ...grpc.CallOption) (*ResourceSet, error)
+	ListResourceRevisions(ctx context.Context, in *ListResourceRevisionsRequest, opts ...grpc.CallOption) (*RevisionSet, error)
+	DiffResourceRevisions(ctx context.Context, in *DiffResourceRevisionsRequest, opts ...grpc.CallOption) (*RevisionDiff, error)
+	LookupByIP(ctx context.Context, in *LookupByIPRequest, opts ...grpc.CallOption) (*ResourceSet, error)
+	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateResult, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Service_WatchClient, error)
 }
 
 type serviceClient struct {
@@ -50,6 +70,38 @@ func (c *serviceClient) Search(ctx context.Context, in *SearchRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) StreamSearch(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Service_StreamSearchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[0], Service_StreamSearch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceStreamSearchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_StreamSearchClient interface {
+	Recv() (*Resource, error)
+	grpc.ClientStream
+}
+
+type serviceStreamSearchClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceStreamSearchClient) Recv() (*Resource, error) {
+	m := new(Resource)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *serviceClient) QueryTag(ctx context.Context, in *QueryTagRequest, opts ...grpc.CallOption) (*TagSet, error) {
 	out := new(TagSet)
 	err := c.cc.Invoke(ctx, Service_QueryTag_FullMethodName, in, out, opts...)
@@ -68,13 +120,127 @@ func (c *serviceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opt
 	return out, nil
 }
 
+func (c *serviceClient) Save(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveResult, error) {
+	out := new(SaveResult)
+	err := c.cc.Invoke(ctx, Service_Save_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BatchSave(ctx context.Context, in *BatchSaveRequest, opts ...grpc.CallOption) (*SaveResultSet, error) {
+	out := new(SaveResultSet)
+	err := c.cc.Invoke(ctx, Service_BatchSave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetSharedPolicy(ctx context.Context, in *SetSharedPolicyRequest, opts ...grpc.CallOption) (*Resource, error) {
+	out := new(Resource)
+	err := c.cc.Invoke(ctx, Service_SetSharedPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ExpiringResources(ctx context.Context, in *ExpiringResourcesRequest, opts ...grpc.CallOption) (*ResourceSet, error) {
+	out := new(ResourceSet)
+	err := c.cc.Invoke(ctx, Service_ExpiringResources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListResourceRevisions(ctx context.Context, in *ListResourceRevisionsRequest, opts ...grpc.CallOption) (*RevisionSet, error) {
+	out := new(RevisionSet)
+	err := c.cc.Invoke(ctx, Service_ListResourceRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) DiffResourceRevisions(ctx context.Context, in *DiffResourceRevisionsRequest, opts ...grpc.CallOption) (*RevisionDiff, error) {
+	out := new(RevisionDiff)
+	err := c.cc.Invoke(ctx, Service_DiffResourceRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) LookupByIP(ctx context.Context, in *LookupByIPRequest, opts ...grpc.CallOption) (*ResourceSet, error) {
+	out := new(ResourceSet)
+	err := c.cc.Invoke(ctx, Service_LookupByIP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateResult, error) {
+	out := new(AggregateResult)
+	err := c.cc.Invoke(ctx, Service_Aggregate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Service_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[1], Service_Watch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_WatchClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type serviceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceWatchClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
 type ServiceServer interface {
 	Search(context.Context, *SearchRequest) (*ResourceSet, error)
+	StreamSearch(*SearchRequest, Service_StreamSearchServer) error
 	QueryTag(context.Context, *QueryTagRequest) (*TagSet, error)
 	UpdateTag(context.Context, *UpdateTagRequest) (*Resource, error)
+	Save(context.Context, *SaveRequest) (*SaveResult, error)
+	BatchSave(context.Context, *BatchSaveRequest) (*SaveResultSet, error)
+	SetSharedPolicy(context.Context, *SetSharedPolicyRequest) (*Resource, error)
+	ExpiringResources(context.Context, *ExpiringResourcesRequest) (*ResourceSet, error)
+	ListResourceRevisions(context.Context, *ListResourceRevisionsRequest) (*RevisionSet, error)
+	DiffResourceRevisions(context.Context, *DiffResourceRevisionsRequest) (*RevisionDiff, error)
+	LookupByIP(context.Context, *LookupByIPRequest) (*ResourceSet, error)
+	Aggregate(context.Context, *AggregateRequest) (*AggregateResult, error)
+	Watch(*WatchRequest, Service_WatchServer) error
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -85,12 +251,42 @@ type UnimplementedServiceServer struct {
 func (UnimplementedServiceServer) Search(context.Context, *SearchRequest) (*ResourceSet, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedServiceServer) StreamSearch(*SearchRequest, Service_StreamSearchServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSearch not implemented")
+}
 func (UnimplementedServiceServer) QueryTag(context.Context, *QueryTagRequest) (*TagSet, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryTag not implemented")
 }
 func (UnimplementedServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*Resource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
 }
+func (UnimplementedServiceServer) Save(context.Context, *SaveRequest) (*SaveResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Save not implemented")
+}
+func (UnimplementedServiceServer) BatchSave(context.Context, *BatchSaveRequest) (*SaveResultSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSave not implemented")
+}
+func (UnimplementedServiceServer) SetSharedPolicy(context.Context, *SetSharedPolicyRequest) (*Resource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSharedPolicy not implemented")
+}
+func (UnimplementedServiceServer) ExpiringResources(context.Context, *ExpiringResourcesRequest) (*ResourceSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringResources not implemented")
+}
+func (UnimplementedServiceServer) ListResourceRevisions(context.Context, *ListResourceRevisionsRequest) (*RevisionSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceRevisions not implemented")
+}
+func (UnimplementedServiceServer) DiffResourceRevisions(context.Context, *DiffResourceRevisionsRequest) (*RevisionDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffResourceRevisions not implemented")
+}
+func (UnimplementedServiceServer) LookupByIP(context.Context, *LookupByIPRequest) (*ResourceSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupByIP not implemented")
+}
+func (UnimplementedServiceServer) Aggregate(context.Context, *AggregateRequest) (*AggregateResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
+}
+func (UnimplementedServiceServer) Watch(*WatchRequest, Service_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -122,6 +318,27 @@ func _Service_Search_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_StreamSearch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).StreamSearch(m, &serviceStreamSearchServer{stream})
+}
+
+type Service_StreamSearchServer interface {
+	Send(*Resource) error
+	grpc.ServerStream
+}
+
+type serviceStreamSearchServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceStreamSearchServer) Send(m *Resource) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Service_QueryTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTagRequest)
 	if err := dec(in); err != nil {
@@ -158,6 +375,171 @@ func _Service_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_Save_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Save(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Save_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Save(ctx, req.(*SaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BatchSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BatchSave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_BatchSave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BatchSave(ctx, req.(*BatchSaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetSharedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSharedPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetSharedPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SetSharedPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetSharedPolicy(ctx, req.(*SetSharedPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ExpiringResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpiringResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ExpiringResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ExpiringResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ExpiringResources(ctx, req.(*ExpiringResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListResourceRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListResourceRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListResourceRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListResourceRevisions(ctx, req.(*ListResourceRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_DiffResourceRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffResourceRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).DiffResourceRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_DiffResourceRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).DiffResourceRevisions(ctx, req.(*DiffResourceRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_LookupByIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupByIPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).LookupByIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_LookupByIP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).LookupByIP(ctx, req.(*LookupByIPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Aggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Aggregate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Aggregate(ctx, req.(*AggregateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).Watch(m, &serviceWatchServer{stream})
+}
+
+type Service_WatchServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type serviceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceWatchServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,7 +559,50 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTag",
 			Handler:    _Service_UpdateTag_Handler,
 		},
+		{
+			MethodName: "Save",
+			Handler:    _Service_Save_Handler,
+		},
+		{
+			MethodName: "BatchSave",
+			Handler:    _Service_BatchSave_Handler,
+		},
+		{
+			MethodName: "SetSharedPolicy",
+			Handler:    _Service_SetSharedPolicy_Handler,
+		},
+		{
+			MethodName: "ExpiringResources",
+			Handler:    _Service_ExpiringResources_Handler,
+		},
+		{
+			MethodName: "ListResourceRevisions",
+			Handler:    _Service_ListResourceRevisions_Handler,
+		},
+		{
+			MethodName: "DiffResourceRevisions",
+			Handler:    _Service_DiffResourceRevisions_Handler,
+		},
+		{
+			MethodName: "LookupByIP",
+			Handler:    _Service_LookupByIP_Handler,
+		},
+		{
+			MethodName: "Aggregate",
+			Handler:    _Service_Aggregate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSearch",
+			Handler:       _Service_StreamSearch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _Service_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "apps/resource/pb/resource.proto",
 }
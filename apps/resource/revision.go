@@ -0,0 +1,226 @@
+package resource
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/opengoats/goat/http/request"
+	"github.com/rs/xid"
+)
+
+const (
+	// 快照中特有属性字段的前缀
+	SnapshotDescribePrefix = "describe."
+	// 快照中标签字段的前缀, 同一个key有多个值时, 按值排序后以逗号分隔
+	SnapshotTagPrefix = "tag."
+)
+
+// Snapshot 资源某一时刻的扁平化快照, 字段名与json字段名保持一致
+type Snapshot map[string]string
+
+// NewSnapshot 使用资源通用属性, 特有属性和标签构建快照
+func NewSnapshot(r *Resource, describe map[string]string) Snapshot {
+	s := Snapshot{
+		"vendor":        r.Vendor.String(),
+		"resource_type": r.ResourceType.String(),
+		"c_id":          r.Cid,
+		"secret_id":     r.SecretId,
+		"region":        r.Region,
+		"zone":          r.Zone,
+		"domain":        r.Domain,
+		"namespace":     r.Namespace,
+		"env":           r.Env,
+		"usage_mode":    r.UsageMode.String(),
+		"expire_at":     strconv.FormatInt(r.ExpireAt, 10),
+		"category":      r.Category,
+		"type":          r.Type,
+		"name":          r.Name,
+		"description":   r.Description,
+		"c_status":      r.CStatus,
+		"sync_account":  r.SyncAccount,
+		"public_ip":     r.PublicIPToString(),
+		"private_ip":    r.PrivateIPToString(),
+		"pay_type":      r.PayType,
+	}
+	for k, v := range describe {
+		s[SnapshotDescribePrefix+k] = v
+	}
+	s.SetTags(r.Tags)
+	return s
+}
+
+// SetTags 整体替换快照中的标签
+func (s Snapshot) SetTags(tags []*Tag) {
+	for k := range s {
+		if strings.HasPrefix(k, SnapshotTagPrefix) {
+			delete(s, k)
+		}
+	}
+
+	values := map[string][]string{}
+	for i := range tags {
+		values[tags[i].Key] = append(values[tags[i].Key], tags[i].Value)
+	}
+	for k, v := range values {
+		sort.Strings(v)
+		s[SnapshotTagPrefix+k] = strings.Join(v, ",")
+	}
+}
+
+// Clone 复制一份快照, 用于在上一个版本的基础上修改
+func (s Snapshot) Clone() Snapshot {
+	c := make(Snapshot, len(s))
+	for k, v := range s {
+		c[k] = v
+	}
+	return c
+}
+
+func (s Snapshot) String() string {
+	if s == nil {
+		return ""
+	}
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+func LoadSnapshotString(str string) (Snapshot, error) {
+	if str == "" {
+		return nil, nil
+	}
+	s := Snapshot{}
+	if err := json.Unmarshal([]byte(str), &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Diff 对比两个快照, 按字段名排序返回有变化的字段
+func (s Snapshot) Diff(target Snapshot) []*FieldDiff {
+	fields := map[string]bool{}
+	for k := range s {
+		fields[k] = true
+	}
+	for k := range target {
+		fields[k] = true
+	}
+
+	diffs := []*FieldDiff{}
+	for k := range fields {
+		before, inBefore := s[k]
+		after, inAfter := target[k]
+		switch {
+		case !inBefore:
+			diffs = append(diffs, &FieldDiff{Field: k, Operation: DiffOperation_ADDED, After: after})
+		case !inAfter:
+			diffs = append(diffs, &FieldDiff{Field: k, Operation: DiffOperation_REMOVED, Before: before})
+		case before != after:
+			diffs = append(diffs, &FieldDiff{Field: k, Operation: DiffOperation_MODIFIED, Before: before, After: after})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Field < diffs[j].Field })
+	return diffs
+}
+
+// NewRevision 对比变更前后的快照生成一次变更, 没有变化时返回nil
+func NewRevision(resourceId, actor string, action RevisionAction, before, after Snapshot) *Revision {
+	diffs := before.Diff(after)
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	r := &Revision{
+		Id:            xid.New().String(),
+		ResourceId:    resourceId,
+		Actor:         actor,
+		Action:        action,
+		ChangedFields: make([]string, 0, len(diffs)),
+		Before:        before,
+		After:         after,
+	}
+	for i := range diffs {
+		r.ChangedFields = append(r.ChangedFields, diffs[i].Field)
+	}
+	return r
+}
+
+func NewDefaultRevision() *Revision {
+	return &Revision{
+		ChangedFields: []string{},
+	}
+}
+
+// ChangedFieldsToString 数据库中多个字段以逗号分隔存储
+func (r *Revision) ChangedFieldsToString() string {
+	return strings.Join(r.ChangedFields, ",")
+}
+
+func (r *Revision) LoadChangedFieldsString(s string) {
+	if s != "" {
+		r.ChangedFields = strings.Split(s, ",")
+	}
+}
+
+func (r *ListResourceRevisionsRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewListResourceRevisionsRequest(resourceId string) *ListResourceRevisionsRequest {
+	return &ListResourceRevisionsRequest{
+		Page:       request.NewDefaultPageRequest(),
+		ResourceId: resourceId,
+	}
+}
+
+// NewListResourceRevisionsRequestFromHTTP 资源Id从路径中获取, 其他参数从Query参数中加载
+func NewListResourceRevisionsRequestFromHTTP(r *http.Request, resourceId string) *ListResourceRevisionsRequest {
+	qs := r.URL.Query()
+
+	req := NewListResourceRevisionsRequest(resourceId)
+	req.Page = request.NewPageRequestFromHTTP(r)
+	req.Field = qs.Get("field")
+	req.WithSnapshot = qs.Get("with_snapshot") == "true"
+	return req
+}
+
+func NewRevisionSet() *RevisionSet {
+	return &RevisionSet{
+		Items: []*Revision{},
+	}
+}
+
+func (s *RevisionSet) Add(item *Revision) {
+	s.Items = append(s.Items, item)
+}
+
+func (r *DiffResourceRevisionsRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewDiffResourceRevisionsRequest(a, b string) *DiffResourceRevisionsRequest {
+	return &DiffResourceRevisionsRequest{
+		A: a,
+		B: b,
+	}
+}
+
+// NewRevisionDiff 对比两次变更后的快照
+func NewRevisionDiff(a, b *Revision) *RevisionDiff {
+	return &RevisionDiff{
+		ResourceId: a.ResourceId,
+		A:          a,
+		B:          b,
+		Items:      Snapshot(a.After).Diff(Snapshot(b.After)),
+	}
+}
@@ -0,0 +1,69 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotDiff(t *testing.T) {
+	should := assert.New(t)
+
+	ins := resource.NewDefaultResource()
+	ins.Name = "web-01"
+	ins.PrivateIp = []string{"10.0.0.1"}
+	ins.Tags = []*resource.Tag{
+		{Key: "app", Value: "web"},
+		{Key: "team", Value: "b"},
+		{Key: "team", Value: "a"},
+	}
+	before := resource.NewSnapshot(ins, map[string]string{"cpu": "2"})
+	should.Equal("a,b", before["tag.team"])
+	should.Equal("2", before["describe.cpu"])
+	should.Equal("ALIYUN", before["vendor"])
+
+	ins.PrivateIp = []string{"10.0.0.2"}
+	ins.Tags = []*resource.Tag{{Key: "team", Value: "a"}, {Key: "env", Value: "prod"}}
+	after := resource.NewSnapshot(ins, map[string]string{"cpu": "2", "memory": "4096"})
+
+	diffs := before.Diff(after)
+	should.Equal([]*resource.FieldDiff{
+		{Field: "describe.memory", Operation: resource.DiffOperation_ADDED, After: "4096"},
+		{Field: "private_ip", Operation: resource.DiffOperation_MODIFIED, Before: "10.0.0.1", After: "10.0.0.2"},
+		{Field: "tag.app", Operation: resource.DiffOperation_REMOVED, Before: "web"},
+		{Field: "tag.env", Operation: resource.DiffOperation_ADDED, After: "prod"},
+		{Field: "tag.team", Operation: resource.DiffOperation_MODIFIED, Before: "a,b", After: "a"},
+	}, diffs)
+}
+
+func TestNewRevision(t *testing.T) {
+	should := assert.New(t)
+
+	ins := resource.NewDefaultResource()
+	ins.Name = "db-01"
+	before := resource.NewSnapshot(ins, nil)
+
+	// 没有变化时不记录
+	should.Nil(resource.NewRevision("r1", "task:t1", resource.RevisionAction_UPDATE, before, before.Clone()))
+
+	after := before.Clone()
+	after.SetTags([]*resource.Tag{{Key: "owner", Value: "ops"}})
+	rev := resource.NewRevision("r1", "user:bob", resource.RevisionAction_UPDATE_TAG, before, after)
+	if should.NotNil(rev) {
+		should.Equal([]string{"tag.owner"}, rev.ChangedFields)
+		should.Equal("user:bob", rev.Actor)
+	}
+
+	// 新建时所有字段都是新增的
+	rev = resource.NewRevision("r1", "task:t1", resource.RevisionAction_CREATE, nil, before)
+	if should.NotNil(rev) {
+		should.Len(rev.ChangedFields, len(before))
+	}
+
+	// 快照序列化
+	s, err := resource.LoadSnapshotString(after.String())
+	if should.NoError(err) {
+		should.Equal(after, s)
+	}
+}
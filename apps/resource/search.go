@@ -0,0 +1,169 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/sqlbuilder"
+)
+
+const (
+	// 标签选择器, 每个选择器一个子查询, 多个选择器之间为AND关系
+	sqlTagSelector = `EXISTS (SELECT 1 FROM resource_tag st WHERE st.resource_id = r.id AND %s)`
+)
+
+// NewSearchQuery 根据搜索条件构建查询语句, 资源表的别名需要为r
+// base中需要保留JOIN方式的占位符, 比如: SELECT r.* FROM resource r %s JOIN resource_tag t ON r.id = t.resource_id
+// 返回的join用于构建相同条件的COUNT语句
+func NewSearchQuery(base string, req *SearchRequest) (query *sqlbuilder.Builder, join string, err error) {
+	// 没有标签过滤时使用LEFT JOIN, 保证没有标签的资源也能被查询出来
+	join, tagStmts, tagArgs, err := BuildTagSelectors(req.Tags)
+	if err != nil {
+		return nil, "", err
+	}
+	query = sqlbuilder.NewQuery(base, join)
+	query.WithWhere(tagStmts, tagArgs)
+
+	// 构建过滤条件, 默认不返回已经释放的资源
+	if !req.IncludeReleased {
+		query.Where("r.status > 0")
+	}
+	if req.Domain != "" {
+		query.Where("r.domain = ?", req.Domain)
+	}
+	if req.Namespace != "" {
+		query.Where("r.namespace = ?", req.Namespace)
+	}
+	if req.Env != "" {
+		query.Where("r.env = ?", req.Env)
+	}
+	if req.UsageMode != nil {
+		query.Where("r.usage_mode = ?", *req.UsageMode)
+	}
+	if req.Vendor != nil {
+		query.Where("r.vendor = ?", *req.Vendor)
+	}
+	if req.SyncAccount != "" {
+		query.Where("r.sync_accout = ?", req.SyncAccount)
+	}
+	if req.Type != nil {
+		query.Where("r.resource_type = ?", *req.Type)
+	}
+	if req.Status != "" {
+		query.Where("r.c_status = ?", req.Status)
+	}
+	// IP过滤条件
+	ipStmts, ipArgs, err := req.BuildIPFilters()
+	if err != nil {
+		return nil, "", exception.NewBadRequest("%s", err)
+	}
+	query.WithWhere(ipStmts, ipArgs)
+	// 只返回调用方有权访问的资源, 调用方只有服务内部调用时为空, 参考NewCallerFromContext
+	if req.Caller != nil {
+		stmt, args := req.Caller.BuildSQL()
+		query.Where(stmt, args...)
+	}
+	if req.Keywords != "" {
+		if req.ExactMatch {
+			// IP以逗号分隔存储, 精确匹配时使用FIND_IN_SET
+			query.Where("(r.name = ? OR r.c_id = ? OR FIND_IN_SET(?, r.private_ip) OR FIND_IN_SET(?, r.public_ip))",
+				req.Keywords,
+				req.Keywords,
+				req.Keywords,
+				req.Keywords,
+			)
+		} else {
+			query.Where("(r.name LIKE ? OR r.c_id = ? OR r.description LIKE ? OR r.private_ip LIKE ? OR r.public_ip LIKE ?)",
+				"%"+req.Keywords+"%",
+				req.Keywords,
+				"%"+req.Keywords+"%",
+				"%"+req.Keywords+"%",
+				"%"+req.Keywords+"%",
+			)
+		}
+	}
+
+	return query, join, nil
+}
+
+// BuildTagSelectors 编译所有的标签选择器, 并决定JOIN方式
+// 只要有一个正向的选择器, 资源就必须有标签, 使用INNER JOIN, 否则使用LEFT JOIN
+func BuildTagSelectors(selectors []*TagSelector) (join string, stmts []string, args []interface{}, err error) {
+	join = "LEFT"
+	for i := range selectors {
+		stmt, selectorArgs, err := selectors[i].BuildSQL()
+		if err != nil {
+			return "", nil, nil, err
+		}
+		op, _ := ParseOperatorFromString(selectors[i].Operator)
+		if !op.IsNegative() {
+			join = "INNER"
+		}
+		stmts = append(stmts, stmt)
+		args = append(args, selectorArgs...)
+	}
+	return join, stmts, args, nil
+}
+
+// BuildSQL 把一个标签选择器编译为WHERE条件
+func (s *TagSelector) BuildSQL() (string, []interface{}, error) {
+	if err := s.Validate(); err != nil {
+		return "", nil, exception.NewBadRequest("invalid tag selector, %s", err)
+	}
+	op, _ := ParseOperatorFromString(s.Operator)
+
+	conds := []string{}
+	args := []interface{}{}
+
+	// key的匹配条件
+	switch s.KeyMatchMode() {
+	case KeyMatchRegexp:
+		conds = append(conds, "st.t_key REGEXP ?")
+		args = append(args, s.KeyPattern())
+	case KeyMatchWildcard:
+		conds = append(conds, "st.t_key LIKE ?")
+		args = append(args, WildcardToLike(s.Key))
+	default:
+		conds = append(conds, "st.t_key = ?")
+		args = append(args, s.Key)
+	}
+
+	// value的匹配条件, exists/not exists 只匹配key
+	switch op {
+	case OperatorEqual, OperatorNotEqual, OperatorIn, OperatorNotIn:
+		conds = append(conds, "st.t_value IN (?"+strings.Repeat(",?", len(s.Values)-1)+")")
+		args = append(args, StringsToArgs(s.Values)...)
+	case OperatorLike:
+		conds = append(conds, orConditions("st.t_value LIKE ?", len(s.Values)))
+		for i := range s.Values {
+			args = append(args, WildcardToLike(s.Values[i]))
+		}
+	case OperatorRegexp, OperatorNotRegexp:
+		conds = append(conds, orConditions("st.t_value REGEXP ?", len(s.Values)))
+		args = append(args, StringsToArgs(s.Values)...)
+	}
+
+	stmt := fmt.Sprintf(sqlTagSelector, strings.Join(conds, " AND "))
+	if op.IsNegative() {
+		stmt = "NOT " + stmt
+	}
+	return stmt, args, nil
+}
+
+func orConditions(cond string, n int) string {
+	conds := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		conds = append(conds, cond)
+	}
+	return "(" + strings.Join(conds, " OR ") + ")"
+}
+
+// StringsToArgs 把字符串列表转换为SQL参数
+func StringsToArgs(items []string) []interface{} {
+	args := make([]interface{}, 0, len(items))
+	for i := range items {
+		args = append(args, items[i])
+	}
+	return args
+}
@@ -0,0 +1,98 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/exception"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagSelectorBuildSQL(t *testing.T) {
+	should := assert.New(t)
+
+	stmt, args, err := (&resource.TagSelector{Key: "app", Operator: "=", Values: []string{"payments"}}).BuildSQL()
+	if should.NoError(err) {
+		should.Equal("EXISTS (SELECT 1 FROM resource_tag st WHERE st.resource_id = r.id AND st.t_key = ? AND st.t_value IN (?))", stmt)
+		should.Equal([]interface{}{"app", "payments"}, args)
+	}
+
+	stmt, args, err = (&resource.TagSelector{Key: "env", Operator: "NOT  IN", Values: []string{"prod", "pre"}}).BuildSQL()
+	if should.NoError(err) {
+		should.Equal("NOT EXISTS (SELECT 1 FROM resource_tag st WHERE st.resource_id = r.id AND st.t_key = ? AND st.t_value IN (?,?))", stmt)
+		should.Equal([]interface{}{"env", "prod", "pre"}, args)
+	}
+
+	stmt, args, err = (&resource.TagSelector{Key: "promethues.io/*", Operator: "exists"}).BuildSQL()
+	if should.NoError(err) {
+		should.Equal("EXISTS (SELECT 1 FROM resource_tag st WHERE st.resource_id = r.id AND st.t_key LIKE ?)", stmt)
+		should.Equal([]interface{}{"promethues.io/%"}, args)
+	}
+
+	stmt, args, err = (&resource.TagSelector{Key: "/^app_.+$/", Operator: "=~", Values: []string{"^pay", "^order"}}).BuildSQL()
+	if should.NoError(err) {
+		should.Equal("EXISTS (SELECT 1 FROM resource_tag st WHERE st.resource_id = r.id AND st.t_key REGEXP ? AND (st.t_value REGEXP ? OR st.t_value REGEXP ?))", stmt)
+		should.Equal([]interface{}{"^app_.+$", "^pay", "^order"}, args)
+	}
+
+	_, args, err = (&resource.TagSelector{Key: "owner", Operator: "like", Values: []string{"100%_*"}}).BuildSQL()
+	if should.NoError(err) {
+		should.Equal([]interface{}{"owner", `100\%\_%`}, args)
+	}
+}
+
+func TestTagSelectorBuildSQLInvalid(t *testing.T) {
+	should := assert.New(t)
+
+	cases := []*resource.TagSelector{
+		{Key: "app", Operator: ">", Values: []string{"1"}},
+		{Key: "app", Operator: "=", Values: []string{"a", "b"}},
+		{Key: "app", Operator: "exists", Values: []string{"a"}},
+		{Key: "app", Operator: "in"},
+		{Key: "", Operator: "="},
+	}
+	for _, c := range cases {
+		_, _, err := c.BuildSQL()
+		if should.Error(err) {
+			e, ok := err.(exception.APIException)
+			should.True(ok)
+			should.Equal(exception.BadRequest, e.ErrorCode())
+		}
+	}
+}
+
+func TestNewSearchQuery(t *testing.T) {
+	should := assert.New(t)
+
+	base := "SELECT r.id FROM resource r %s JOIN resource_tag t ON r.id = t.resource_id"
+
+	req := resource.NewSearchRequest()
+	_, join, err := resource.NewSearchQuery(base, req)
+	should.NoError(err)
+	should.Equal("LEFT", join)
+
+	req.Tags = []*resource.TagSelector{{Key: "env", Operator: "!=", Values: []string{"prod"}}}
+	_, join, err = resource.NewSearchQuery(base, req)
+	should.NoError(err)
+	should.Equal("LEFT", join)
+
+	req.Tags = []*resource.TagSelector{
+		{Key: "app", Operator: "=", Values: []string{"payments"}},
+		{Key: "env", Operator: "!=", Values: []string{"prod"}},
+	}
+	req.Namespace = "default"
+	query, join, err := resource.NewSearchQuery(base, req)
+	should.NoError(err)
+	should.Equal("INNER", join)
+	stmt, args := query.Build()
+	should.Contains(stmt, "SELECT r.id FROM resource r INNER JOIN resource_tag t ON r.id = t.resource_id")
+	should.Equal([]interface{}{"app", "payments", "env", "prod", "default"}, args)
+	should.Contains(stmt, "r.status > 0")
+
+	// 包含已经释放的资源
+	req.IncludeReleased = true
+	query, _, err = resource.NewSearchQuery(base, req)
+	should.NoError(err)
+	stmt, _ = query.Build()
+	should.NotContains(stmt, "r.status > 0")
+}
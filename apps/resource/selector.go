@@ -0,0 +1,255 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Operator 标签选择器的匹配符
+type Operator string
+
+const (
+	// 等于, 只允许一个值
+	OperatorEqual Operator = "="
+	// 不等于, 只允许一个值, 没有该标签的资源同样会被匹配
+	OperatorNotEqual Operator = "!="
+	// 值在列表中
+	OperatorIn Operator = "in"
+	// 值不在列表中, 没有该标签的资源同样会被匹配
+	OperatorNotIn Operator = "not in"
+	// 存在该标签, 不需要值
+	OperatorExists Operator = "exists"
+	// 不存在该标签, 不需要值
+	OperatorNotExists Operator = "not exists"
+	// 通配符匹配值, *匹配任意字符, 多个值之间为或的关系
+	OperatorLike Operator = "like"
+	// 正则匹配值, 多个值之间为或的关系
+	OperatorRegexp Operator = "=~"
+	// 正则不匹配值, 没有该标签的资源同样会被匹配
+	OperatorNotRegexp Operator = "!~"
+)
+
+var (
+	operators = []Operator{
+		OperatorEqual,
+		OperatorNotEqual,
+		OperatorIn,
+		OperatorNotIn,
+		OperatorExists,
+		OperatorNotExists,
+		OperatorLike,
+		OperatorRegexp,
+		OperatorNotRegexp,
+	}
+)
+
+// ParseOperatorFromString 解析匹配符, 忽略大小写和多余的空格, 为空时默认为等于
+func ParseOperatorFromString(str string) (Operator, error) {
+	key := strings.ToLower(strings.Join(strings.Fields(str), " "))
+	if key == "" {
+		return OperatorEqual, nil
+	}
+
+	for _, op := range operators {
+		if string(op) == key {
+			return op, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown tag selector operator: %s, support: %s", str, operators)
+}
+
+// IsNegative 是否是取反的匹配符, 取反时没有该标签的资源也会被匹配
+func (o Operator) IsNegative() bool {
+	switch o {
+	case OperatorNotEqual, OperatorNotIn, OperatorNotExists, OperatorNotRegexp:
+		return true
+	}
+	return false
+}
+
+// KeyMatchMode key的匹配方式
+type KeyMatchMode int
+
+const (
+	// 精确匹配
+	KeyMatchExact KeyMatchMode = iota
+	// 通配符匹配, key中包含*, 比如 promethues.io/*
+	KeyMatchWildcard
+	// 正则匹配, key使用/包裹, 比如 /^promethues\.io\/.*$/
+	KeyMatchRegexp
+)
+
+// KeyMatchMode 根据key的写法判断匹配方式
+func (s *TagSelector) KeyMatchMode() KeyMatchMode {
+	if len(s.Key) > 2 && strings.HasPrefix(s.Key, "/") && strings.HasSuffix(s.Key, "/") {
+		return KeyMatchRegexp
+	}
+	if strings.Contains(s.Key, "*") {
+		return KeyMatchWildcard
+	}
+	return KeyMatchExact
+}
+
+// KeyPattern 去掉正则包裹符后的key
+func (s *TagSelector) KeyPattern() string {
+	if s.KeyMatchMode() == KeyMatchRegexp {
+		return s.Key[1 : len(s.Key)-1]
+	}
+	return s.Key
+}
+
+// Validate 校验选择器, 匹配符和值的个数需要对应
+func (s *TagSelector) Validate() error {
+	if s.Key == "" {
+		return fmt.Errorf("tag selector key required")
+	}
+
+	op, err := ParseOperatorFromString(s.Operator)
+	if err != nil {
+		return err
+	}
+
+	switch op {
+	case OperatorEqual, OperatorNotEqual:
+		if len(s.Values) != 1 {
+			return fmt.Errorf("tag selector %s operator %s requires exactly one value", s.Key, op)
+		}
+	case OperatorExists, OperatorNotExists:
+		if len(s.Values) != 0 {
+			return fmt.Errorf("tag selector %s operator %s does not accept values", s.Key, op)
+		}
+	default:
+		if len(s.Values) == 0 {
+			return fmt.Errorf("tag selector %s operator %s requires at least one value", s.Key, op)
+		}
+	}
+
+	return nil
+}
+
+// WildcardToLike 把通配符转换为LIKE语句的匹配串, 转义原有的%和_
+func WildcardToLike(pattern string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `*`, `%`)
+	return r.Replace(pattern)
+}
+
+// ParseTagSelectors 解析紧凑格式的标签选择器, 多个选择器之间以逗号分隔, 括号内的逗号用于分隔多个值
+//
+//	app=payments            等于
+//	env!=prod               不等于
+//	env in (prod,pre)       值在列表中
+//	env not in (prod,pre)   值不在列表中, 也可以写作 notin
+//	owner                   存在该标签
+//	!deprecated             不存在该标签
+//	name like (web-*,api-*) 通配符匹配
+//	app=~^pay               正则匹配
+//	app!~^pay               正则不匹配
+//
+// 正则中包含逗号时, 请使用多个查询参数分别传递
+func ParseTagSelectors(str string) ([]*TagSelector, error) {
+	selectors := []*TagSelector{}
+	for _, term := range splitTopLevel(str) {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		s, err := parseTagSelector(term)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.Validate(); err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, s)
+	}
+	return selectors, nil
+}
+
+// splitTopLevel 以逗号分隔, 忽略括号内的逗号
+func splitTopLevel(str string) (terms []string) {
+	depth, start := 0, 0
+	for i, c := range str {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				terms = append(terms, str[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(terms, str[start:])
+}
+
+func parseTagSelector(term string) (*TagSelector, error) {
+	// 集合类的匹配符: key in (v1,v2)
+	fields := strings.Fields(term)
+	if len(fields) >= 2 {
+		key := fields[0]
+		rest := strings.TrimSpace(strings.TrimPrefix(term, key))
+		lower := strings.ToLower(rest)
+		for _, op := range []Operator{OperatorNotIn, "notin", OperatorIn, OperatorLike} {
+			if !strings.HasPrefix(lower, string(op)) {
+				continue
+			}
+			values := strings.TrimSpace(rest[len(op):])
+			if op == "notin" {
+				op = OperatorNotIn
+			}
+			if strings.HasPrefix(values, "(") && strings.HasSuffix(values, ")") {
+				values = values[1 : len(values)-1]
+			}
+			return &TagSelector{Key: key, Operator: string(op), Values: splitValues(values)}, nil
+		}
+	}
+
+	// 符号类的匹配符, 以第一次出现的匹配符为准
+	for i := 0; i < len(term); i++ {
+		var op Operator
+		var n int
+		switch {
+		case strings.HasPrefix(term[i:], "!="):
+			op, n = OperatorNotEqual, 2
+		case strings.HasPrefix(term[i:], "!~"):
+			op, n = OperatorNotRegexp, 2
+		case strings.HasPrefix(term[i:], "=~"):
+			op, n = OperatorRegexp, 2
+		case strings.HasPrefix(term[i:], "=="):
+			op, n = OperatorEqual, 2
+		case term[i] == '=':
+			op, n = OperatorEqual, 1
+		default:
+			continue
+		}
+		key := strings.TrimSpace(term[:i])
+		if key == "" {
+			return nil, fmt.Errorf("tag selector %s key required", term)
+		}
+		return &TagSelector{Key: key, Operator: string(op), Values: []string{strings.TrimSpace(term[i+n:])}}, nil
+	}
+
+	// 只有key时判断是否存在
+	if strings.HasPrefix(term, "!") {
+		return &TagSelector{Key: strings.TrimSpace(term[1:]), Operator: string(OperatorNotExists)}, nil
+	}
+	if len(fields) != 1 {
+		return nil, fmt.Errorf("invalid tag selector: %s", term)
+	}
+	return &TagSelector{Key: term, Operator: string(OperatorExists)}, nil
+}
+
+func splitValues(str string) []string {
+	values := []string{}
+	for _, v := range strings.Split(str, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,56 @@
+package resource_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+func TestParseTagSelectors(t *testing.T) {
+	should := assert.New(t)
+
+	selectors, err := resource.ParseTagSelectors("app=payments, env not in (prod, pre),team in(a,b),owner,!deprecated,name like (web-*,api-*),ver=~^v1,ver!~-rc$,zone!=a")
+	if should.NoError(err) {
+		should.Equal([]*resource.TagSelector{
+			{Key: "app", Operator: "=", Values: []string{"payments"}},
+			{Key: "env", Operator: "not in", Values: []string{"prod", "pre"}},
+			{Key: "team", Operator: "in", Values: []string{"a", "b"}},
+			{Key: "owner", Operator: "exists"},
+			{Key: "deprecated", Operator: "not exists"},
+			{Key: "name", Operator: "like", Values: []string{"web-*", "api-*"}},
+			{Key: "ver", Operator: "=~", Values: []string{"^v1"}},
+			{Key: "ver", Operator: "!~", Values: []string{"-rc$"}},
+			{Key: "zone", Operator: "!=", Values: []string{"a"}},
+		}, selectors)
+	}
+
+	selectors, err = resource.ParseTagSelectors("env notin (prod)")
+	if should.NoError(err) {
+		should.Equal("not in", selectors[0].Operator)
+	}
+
+	for _, invalid := range []string{"=prod", "env in ()", "env prod"} {
+		_, err := resource.ParseTagSelectors(invalid)
+		should.Error(err, invalid)
+	}
+}
+
+func TestNewSearchRequestFromHTTP(t *testing.T) {
+	should := assert.New(t)
+
+	r := httptest.NewRequest("GET", "/?vendor=aliyun&type=host&with_tags=true&tag=app%3Dweb&tag=env+in+(prod,pre)", nil)
+	req, err := resource.NewSearchRequestFromHTTP(r)
+	if should.NoError(err) {
+		should.Equal(resource.Vendor_ALIYUN, *req.Vendor)
+		should.Equal(resource.Type_HOST, *req.Type)
+		should.True(req.WithTags)
+		should.Len(req.Tags, 2)
+		should.Equal([]string{"prod", "pre"}, req.Tags[1].Values)
+	}
+
+	_, err = resource.NewSearchRequestFromHTTP(httptest.NewRequest("GET", "/?tag=%3Dweb", nil))
+	should.Error(err)
+}
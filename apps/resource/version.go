@@ -0,0 +1,10 @@
+package resource
+
+import (
+	"github.com/opengoats/cmdb/common/version"
+)
+
+// CheckVersion 期望的版本号为0时不校验
+func (r *Resource) CheckVersion(expected int64) error {
+	return version.Check("resource", r.Id, r.Version, expected)
+}
@@ -0,0 +1,32 @@
+package resource_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/goat/exception"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResourceCheckVersion(t *testing.T) {
+	should := assert.New(t)
+
+	ins := resource.NewDefaultResource()
+	ins.Id = "r1"
+	ins.Version = 3
+	should.NoError(ins.CheckVersion(0))
+	should.NoError(ins.CheckVersion(3))
+
+	err := ins.CheckVersion(2)
+	if should.Error(err) {
+		e, ok := err.(exception.APIException)
+		if should.True(ok) {
+			should.Equal(http.StatusPreconditionFailed, e.GetHttpCode())
+			should.Equal(http.StatusPreconditionFailed, e.ErrorCode())
+		}
+		should.Equal(codes.Aborted, status.Code(err))
+	}
+}
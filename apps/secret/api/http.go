@@ -0,0 +1,82 @@
+package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/secret"
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/http/response"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service secret.ServiceServer
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(secret.AppName)
+	h.service = app.GetGrpcApp(secret.AppName).(secret.ServiceServer)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return secret.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"secrets"}
+
+	ws.Route(ws.POST("").To(h.CreateSecret).
+		Doc("create a secret").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(secret.CreateSecretRequest{}).
+		Writes(response.NewMessage(secret.Secret{})))
+
+	ws.Route(ws.GET("/").To(h.QuerySecret).
+		Doc("get all secrets").
+		Param(ws.QueryParameter("keywords", "match description or api key").DataType("string")).
+		Param(ws.QueryParameter("vendor", "resource vendor").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Metadata("action", "list").
+		Writes(response.NewMessage(secret.SecretSet{})).
+		Returns(200, "OK", secret.SecretSet{}))
+
+	ws.Route(ws.GET("/{id}").To(h.DescribeSecret).
+		Doc("get a secret").
+		Param(ws.PathParameter("id", "identifier of the secret").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(response.NewMessage(secret.Secret{})).
+		Returns(200, "OK", response.NewMessage(secret.Secret{})).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.PUT("/{id}").To(h.PutSecret).
+		Doc("update a secret").
+		Param(ws.PathParameter("id", "identifier of the secret").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(secret.CreateSecretRequest{}))
+
+	ws.Route(ws.PATCH("/{id}").To(h.PatchSecret).
+		Doc("patch a secret").
+		Param(ws.PathParameter("id", "identifier of the secret").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(secret.CreateSecretRequest{}))
+
+	ws.Route(ws.DELETE("/{id}").To(h.DeleteSecret).
+		Doc("delete a secret").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Param(ws.PathParameter("id", "identifier of the secret").DataType("string")))
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
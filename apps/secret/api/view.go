@@ -0,0 +1,105 @@
+package api
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/secret"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/response"
+)
+
+func (h *handler) CreateSecret(r *restful.Request, w *restful.Response) {
+	req := secret.NewCreateSecretRequest()
+
+	if err := r.ReadEntity(req); err != nil {
+		h.log.Named("CreateSecret").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read secret error, %s", err))
+		return
+	}
+
+	ins, err := h.service.CreateSecret(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("CreateSecret").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+	response.Success(w.ResponseWriter, ins)
+}
+
+func (h *handler) QuerySecret(r *restful.Request, w *restful.Response) {
+	// 加载查询条件
+	req, err := secret.NewQuerySecretRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("QuerySecret").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse query secret request error, %s", err))
+		return
+	}
+
+	// 数据查询
+	set, err := h.service.QuerySecret(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("QuerySecret").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, set)
+}
+
+func (h *handler) DescribeSecret(r *restful.Request, w *restful.Response) {
+	req := secret.NewDescribeSecretRequest(r.PathParameter("id"))
+	ins, err := h.service.DescribeSecret(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("DescribeSecret").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, ins)
+}
+
+func (h *handler) PutSecret(r *restful.Request, w *restful.Response) {
+	req := secret.NewPutSecretRequest(r.PathParameter("id"))
+
+	if err := r.ReadEntity(req.Data); err != nil {
+		h.log.Named("PutSecret").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read secret error, %s", err))
+		return
+	}
+
+	ins, err := h.service.UpdateSecret(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("PutSecret").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+	response.Success(w.ResponseWriter, ins)
+}
+
+func (h *handler) PatchSecret(r *restful.Request, w *restful.Response) {
+	req := secret.NewPatchSecretRequest(r.PathParameter("id"))
+
+	if err := r.ReadEntity(req.Data); err != nil {
+		h.log.Named("PatchSecret").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read secret error, %s", err))
+		return
+	}
+
+	ins, err := h.service.UpdateSecret(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("PatchSecret").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+	response.Success(w.ResponseWriter, ins)
+}
+
+func (h *handler) DeleteSecret(r *restful.Request, w *restful.Response) {
+	req := secret.NewDeleteSecretRequest(r.PathParameter("id"))
+	ins, err := h.service.DeleteSecret(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("DeleteSecret").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+	response.Success(w.ResponseWriter, ins)
+}
@@ -0,0 +1,16 @@
+package secret
+
+import (
+	"context"
+)
+
+const (
+	AppName = "secret"
+)
+
+// Service 凭证服务, 除了对外暴露的GRPC接口外, 还提供内部使用的解密接口
+type Service interface {
+	ServiceServer
+	// DecryptSecret 返回解密后的凭证, 仅供同步任务等内部模块使用, 不通过GRPC和HTTP暴露
+	DecryptSecret(context.Context, *DescribeSecretRequest) (*Secret, error)
+}
@@ -0,0 +1,53 @@
+package secret
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha1"
+	"errors"
+)
+
+var (
+	errInvalidCipher = errors.New("invalid api secret cipher")
+)
+
+// decryptCBC 与cbc.Decrypt的密文格式一致(IV + 密文, PKCS7填充)
+// cbc.Decrypt解填充时没有校验填充长度, 密文损坏或者应用密钥变更时会panic, 这里校验填充后再返回
+func decryptCBC(data, key []byte) ([]byte, error) {
+	block, err := aes.NewCipher(cbcKey(key))
+	if err != nil {
+		return nil, err
+	}
+
+	blockSize := block.BlockSize()
+	if len(data) < 2*blockSize || len(data)%blockSize != 0 {
+		return nil, errInvalidCipher
+	}
+
+	plain := make([]byte, len(data)-blockSize)
+	cipher.NewCBCDecrypter(block, data[:blockSize]).CryptBlocks(plain, data[blockSize:])
+
+	padding := int(plain[len(plain)-1])
+	if padding < 1 || padding > blockSize || padding > len(plain) {
+		return nil, errInvalidCipher
+	}
+	if !bytes.Equal(plain[len(plain)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return nil, errInvalidCipher
+	}
+	return plain[:len(plain)-padding], nil
+}
+
+// cbcKey 与cbc包派生AES-256密钥的方式一致: 对key做2次sha1, 取前32位
+func cbcKey(key []byte) []byte {
+	h := sha1.New()
+	h.Write(key)
+	hashData := h.Sum(nil)
+	keyBuffer := bytes.NewBuffer(hashData)
+
+	h.Reset()
+	h.Write(hashData)
+	keyBuffer.Write(h.Sum(nil))
+
+	return keyBuffer.Bytes()[:32]
+}
@@ -0,0 +1,249 @@
+package secret
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/imdario/mergo"
+	"github.com/opengoats/goat/crypto/cbc"
+	"github.com/opengoats/goat/http/request"
+	request1 "github.com/opengoats/goat/pb/request"
+	"github.com/rs/xid"
+
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+const (
+	// SecretMask 脱敏后的secret
+	SecretMask = "******"
+)
+
+var (
+	validate = validator.New()
+)
+
+func (r *CreateSecretRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func (r *QuerySecretRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func (r *DescribeSecretRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+// Validate 更新的数据在与原有数据合并后再整体校验, 这里只校验id
+func (r *UpdateSecretRequest) Validate() error {
+	if r.Data == nil {
+		return fmt.Errorf("data required")
+	}
+	if err := validate.StructExcept(r, "Data"); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func (r *DeleteSecretRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func (s *Secret) Validate() error {
+	if err := validate.Struct(s); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewCreateSecretRequest() *CreateSecretRequest {
+	return &CreateSecretRequest{
+		AllowRegions: []string{},
+		RequestRate:  5,
+	}
+}
+
+func NewDefaultSecret() *Secret {
+	return &Secret{
+		Data: NewCreateSecretRequest(),
+	}
+}
+
+func NewSecret(req *CreateSecretRequest) *Secret {
+	return &Secret{
+		Id:       xid.New().String(),
+		CreateAt: time.Now().UnixMilli(),
+		Data:     req,
+	}
+}
+
+// AllowRegionsToString 数据库中多个Region以逗号分隔存储
+func (r *CreateSecretRequest) AllowRegionsToString() string {
+	return strings.Join(r.AllowRegions, ",")
+}
+
+func (r *CreateSecretRequest) LoadAllowRegionsString(s string) {
+	if s != "" {
+		r.AllowRegions = strings.Split(s, ",")
+	}
+}
+
+// IsAllowRegion 是否允许同步该Region, 没有配置时允许所有Region
+func (r *CreateSecretRequest) IsAllowRegion(region string) bool {
+	if len(r.AllowRegions) == 0 {
+		return true
+	}
+	for i := range r.AllowRegions {
+		if r.AllowRegions[i] == region || r.AllowRegions[i] == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// EncryptAPISecret 使用应用密钥加密secret, 密文以base64编码后入库
+func (s *Secret) EncryptAPISecret(key string) error {
+	cipher, err := cbc.Encrypt([]byte(s.Data.ApiSecret), []byte(key))
+	if err != nil {
+		return err
+	}
+	s.Data.ApiSecret = base64.StdEncoding.EncodeToString(cipher)
+	return nil
+}
+
+// DecryptAPISecret 使用应用密钥解密secret, 密文损坏或者密钥不匹配时返回错误
+func (s *Secret) DecryptAPISecret(key string) error {
+	cipher, err := base64.StdEncoding.DecodeString(s.Data.ApiSecret)
+	if err != nil {
+		return err
+	}
+	plain, err := decryptCBC(cipher, []byte(key))
+	if err != nil {
+		return err
+	}
+	s.Data.ApiSecret = string(plain)
+	return nil
+}
+
+// Desensitize 脱敏, 所有对外返回的凭证都需要脱敏
+func (s *Secret) Desensitize() {
+	if s.Data != nil && s.Data.ApiSecret != "" {
+		s.Data.ApiSecret = SecretMask
+	}
+}
+
+// Update 根据更新模式合并数据, api_secret为空或者为脱敏后的值时保持原有的密文
+// 新的secret会使用应用密钥加密
+func (s *Secret) Update(req *UpdateSecretRequest, key string) error {
+	oldSecret, newSecret := s.Data.ApiSecret, req.Data.ApiSecret
+	if newSecret == SecretMask {
+		req.Data.ApiSecret = ""
+	}
+
+	switch req.UpdateMode {
+	case request1.UpdateMode_PATCH:
+		if err := mergo.MergeWithOverwrite(s.Data, req.Data); err != nil {
+			return err
+		}
+	default:
+		s.Data = req.Data
+	}
+
+	if newSecret == "" || newSecret == SecretMask {
+		s.Data.ApiSecret = oldSecret
+		return nil
+	}
+	s.Data.ApiSecret = newSecret
+	return s.EncryptAPISecret(key)
+}
+
+func NewSecretSet() *SecretSet {
+	return &SecretSet{
+		Items: []*Secret{},
+	}
+}
+
+func (s *SecretSet) Add(item *Secret) {
+	s.Items = append(s.Items, item)
+}
+
+// Desensitize 对所有凭证脱敏
+func (s *SecretSet) Desensitize() {
+	for i := range s.Items {
+		s.Items[i].Desensitize()
+	}
+}
+
+func NewQuerySecretRequest() *QuerySecretRequest {
+	return &QuerySecretRequest{
+		Page: request.NewDefaultPageRequest(),
+	}
+}
+
+func NewQuerySecretRequestFromHTTP(r *http.Request) (*QuerySecretRequest, error) {
+	qs := r.URL.Query()
+
+	req := &QuerySecretRequest{
+		Page:     request.NewPageRequestFromHTTP(r),
+		Keywords: qs.Get("keywords"),
+	}
+	if vd := qs.Get("vendor"); vd != "" {
+		v, err := resource.ParseVendorFromString(vd)
+		if err != nil {
+			return nil, err
+		}
+		req.Vendor = &v
+	}
+
+	return req, nil
+}
+
+func NewDescribeSecretRequest(id string) *DescribeSecretRequest {
+	return &DescribeSecretRequest{
+		Id: id,
+	}
+}
+
+func NewPutSecretRequest(id string) *UpdateSecretRequest {
+	return &UpdateSecretRequest{
+		Id:         id,
+		UpdateMode: request1.UpdateMode_PUT,
+		Data:       NewCreateSecretRequest(),
+	}
+}
+
+func NewPatchSecretRequest(id string) *UpdateSecretRequest {
+	return &UpdateSecretRequest{
+		Id:         id,
+		UpdateMode: request1.UpdateMode_PATCH,
+		Data:       &CreateSecretRequest{},
+	}
+}
+
+func NewDeleteSecretRequest(id string) *DeleteSecretRequest {
+	return &DeleteSecretRequest{
+		Id: id,
+	}
+}
@@ -0,0 +1,81 @@
+package secret_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opengoats/cmdb/apps/secret"
+)
+
+const testKey = "unit test encrypt key"
+
+func newTestSecret() *secret.Secret {
+	req := secret.NewCreateSecretRequest()
+	req.Description = "aliyun sync"
+	req.ApiKey = "ak"
+	req.ApiSecret = "sk"
+	return secret.NewSecret(req)
+}
+
+func TestSecretEncrypt(t *testing.T) {
+	should := assert.New(t)
+
+	ins := newTestSecret()
+	if should.NoError(ins.EncryptAPISecret(testKey)) {
+		should.NotEqual("sk", ins.Data.ApiSecret)
+	}
+	if should.NoError(ins.DecryptAPISecret(testKey)) {
+		should.Equal("sk", ins.Data.ApiSecret)
+	}
+
+	ins.Desensitize()
+	should.Equal(secret.SecretMask, ins.Data.ApiSecret)
+}
+
+func TestSecretUpdate(t *testing.T) {
+	should := assert.New(t)
+
+	ins := newTestSecret()
+	should.NoError(ins.EncryptAPISecret(testKey))
+	cipher := ins.Data.ApiSecret
+
+	// 脱敏后的secret原样提交时, 保持原有密文
+	req := secret.NewPatchSecretRequest(ins.Id)
+	req.Data.Description = "patched"
+	req.Data.ApiSecret = secret.SecretMask
+	if should.NoError(ins.Update(req, testKey)) {
+		should.Equal("patched", ins.Data.Description)
+		should.Equal("ak", ins.Data.ApiKey)
+		should.Equal(cipher, ins.Data.ApiSecret)
+	}
+
+	// 新的secret需要重新加密
+	req = secret.NewPutSecretRequest(ins.Id)
+	req.Data.Description = "put"
+	req.Data.ApiKey = "ak2"
+	req.Data.ApiSecret = "sk2"
+	if should.NoError(ins.Update(req, testKey)) {
+		should.NoError(ins.Validate())
+		should.NotEqual("sk2", ins.Data.ApiSecret)
+		should.NoError(ins.DecryptAPISecret(testKey))
+		should.Equal("sk2", ins.Data.ApiSecret)
+	}
+}
+
+func TestSecretDecryptInvalidCipher(t *testing.T) {
+	should := assert.New(t)
+
+	// 只有IV没有密文
+	ins := newTestSecret()
+	ins.Data.ApiSecret = base64.StdEncoding.EncodeToString(make([]byte, 16))
+	should.Error(ins.DecryptAPISecret(testKey))
+
+	// 密钥不匹配时解密出的填充不合法, 不能panic
+	for i := 0; i < 50; i++ {
+		ins = newTestSecret()
+		should.NoError(ins.EncryptAPISecret(testKey))
+		should.NotPanics(func() { _ = ins.DecryptAPISecret("another key") })
+	}
+}
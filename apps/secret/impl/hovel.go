@@ -0,0 +1,201 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/sqlbuilder"
+
+	"github.com/opengoats/cmdb/apps/secret"
+)
+
+const (
+	// mysql唯一键冲突的错误码
+	errDuplicateEntry = 1062
+)
+
+func (s *service) save(ctx context.Context, ins *secret.Secret) error {
+	s.log.Named("CreateSecret").Debugf("sql: %s", sqlInsertSecret)
+	stmt, err := s.db.PrepareContext(ctx, sqlInsertSecret)
+	if err != nil {
+		s.log.Named("CreateSecret").Error(err)
+		return exception.NewInternalServerError("insert secret err %s", err)
+	}
+	defer stmt.Close()
+
+	d := ins.Data
+	_, err = stmt.ExecContext(ctx,
+		ins.Id, ins.CreateAt, d.Description, d.Vendor, d.Address, d.AllowRegionsToString(),
+		d.CredentialType, d.ApiKey, d.ApiSecret, d.RequestRate,
+	)
+	if err != nil {
+		s.log.Named("CreateSecret").Error(err)
+		if isDuplicateEntry(err) {
+			return exception.NewConflict("secret api_key %s already exists", d.ApiKey)
+		}
+		return exception.NewInternalServerError("insert secret err %s", err)
+	}
+
+	return nil
+}
+
+func (s *service) query(ctx context.Context, req *secret.QuerySecretRequest) (*secret.SecretSet, error) {
+	query := sqlbuilder.NewQuery(sqlQuerySecret)
+	if req.Keywords != "" {
+		query.Where("(description LIKE ? OR api_key LIKE ?)",
+			"%"+req.Keywords+"%",
+			"%"+req.Keywords+"%",
+		)
+	}
+	if req.Vendor != nil {
+		query.Where("vendor = ?", *req.Vendor)
+	}
+
+	set := secret.NewSecretSet()
+
+	// 获取total
+	countSQL, args := query.BuildFromNewBase(sqlCountSecret)
+	s.log.Named("QuerySecret").Debugf("sql: %s; %v", countSQL, args)
+	countStmt, err := s.db.PrepareContext(ctx, countSQL)
+	if err != nil {
+		s.log.Named("QuerySecret").Error(err)
+		return nil, exception.NewInternalServerError("count secret err %s", err)
+	}
+	defer countStmt.Close()
+
+	err = countStmt.QueryRowContext(ctx, args...).Scan(&set.Total)
+	if err != nil {
+		s.log.Named("QuerySecret").Error(err)
+		return nil, exception.NewInternalServerError("count secret err %s", err)
+	}
+
+	// 获取分页数据
+	querySQL, args := query.Order("create_at").Desc().
+		Limit(req.Page.ComputeOffset(), uint(req.Page.PageSize)).Build()
+	s.log.Named("QuerySecret").Debugf("sql: %s; %v", querySQL, args)
+	queryStmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("QuerySecret").Error(err)
+		return nil, exception.NewInternalServerError("query secret err %s", err)
+	}
+	defer queryStmt.Close()
+
+	rows, err := queryStmt.QueryContext(ctx, args...)
+	if err != nil {
+		s.log.Named("QuerySecret").Error(err)
+		return nil, exception.NewInternalServerError("query secret err %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ins, err := scanSecret(rows)
+		if err != nil {
+			s.log.Named("QuerySecret").Error(err)
+			return nil, exception.NewInternalServerError("query secret err %s", err)
+		}
+		set.Add(ins)
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("QuerySecret").Error(err)
+		return nil, exception.NewInternalServerError("query secret err %s", err)
+	}
+
+	return set, nil
+}
+
+// describe 返回的secret为数据库中的密文
+func (s *service) describe(ctx context.Context, id string) (*secret.Secret, error) {
+	query := sqlbuilder.NewQuery(sqlQuerySecret).Where("id = ?", id)
+
+	querySQL, args := query.Build()
+	s.log.Named("DescribeSecret").Debugf("sql: %s; %v", querySQL, args)
+	stmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("DescribeSecret").Error(err)
+		return nil, exception.NewInternalServerError("describe secret err %s", err)
+	}
+	defer stmt.Close()
+
+	ins, err := scanSecret(stmt.QueryRowContext(ctx, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, exception.NewNotFound("secret %s not found", id)
+		}
+		s.log.Named("DescribeSecret").Error(err)
+		return nil, exception.NewInternalServerError("describe secret err %s", err)
+	}
+
+	return ins, nil
+}
+
+func (s *service) update(ctx context.Context, ins *secret.Secret) error {
+	s.log.Named("UpdateSecret").Debugf("sql: %s", sqlUpdateSecret)
+	stmt, err := s.db.PrepareContext(ctx, sqlUpdateSecret)
+	if err != nil {
+		s.log.Named("UpdateSecret").Error(err)
+		return exception.NewInternalServerError("update secret err %s", err)
+	}
+	defer stmt.Close()
+
+	d := ins.Data
+	_, err = stmt.ExecContext(ctx,
+		d.Description, d.Vendor, d.Address, d.AllowRegionsToString(), d.CredentialType,
+		d.ApiKey, d.ApiSecret, d.RequestRate,
+		ins.Id,
+	)
+	if err != nil {
+		s.log.Named("UpdateSecret").Error(err)
+		if isDuplicateEntry(err) {
+			return exception.NewConflict("secret api_key %s already exists", d.ApiKey)
+		}
+		return exception.NewInternalServerError("update secret err %s", err)
+	}
+
+	return nil
+}
+
+func (s *service) delete(ctx context.Context, id string) error {
+	s.log.Named("DeleteSecret").Debugf("sql: %s", sqlDeleteSecret)
+	stmt, err := s.db.PrepareContext(ctx, sqlDeleteSecret)
+	if err != nil {
+		s.log.Named("DeleteSecret").Error(err)
+		return exception.NewInternalServerError("delete secret err %s", err)
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, id); err != nil {
+		s.log.Named("DeleteSecret").Error(err)
+		return exception.NewInternalServerError("delete secret err %s", err)
+	}
+
+	return nil
+}
+
+// 字段顺序与sqlQuerySecret保持一致
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSecret(row scanner) (*secret.Secret, error) {
+	ins := secret.NewDefaultSecret()
+	d := ins.Data
+	var allowRegions string
+	err := row.Scan(
+		&ins.Id, &ins.CreateAt, &d.Description, &d.Vendor, &d.Address, &allowRegions,
+		&d.CredentialType, &d.ApiKey, &d.ApiSecret, &d.RequestRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	d.LoadAllowRegionsString(allowRegions)
+	return ins, nil
+}
+
+func isDuplicateEntry(err error) bool {
+	if e, ok := err.(*mysql.MySQLError); ok {
+		return e.Number == errDuplicateEntry
+	}
+	return false
+}
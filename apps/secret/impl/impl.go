@@ -0,0 +1,52 @@
+package impl
+
+import (
+	"database/sql"
+
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+	"google.golang.org/grpc"
+
+	"github.com/opengoats/cmdb/apps/secret"
+	"github.com/opengoats/cmdb/conf"
+)
+
+var (
+	// Service 服务实例
+	svr = &service{}
+	// 内部模块通过secret.Service使用解密接口
+	_ secret.Service = svr
+)
+
+type service struct {
+	db  *sql.DB
+	log logger.Logger
+	// 用于加解密api_secret的应用密钥
+	encryptKey string
+	secret.UnimplementedServiceServer
+}
+
+func (s *service) Config() error {
+	db, err := conf.C().MySQL.GetDB()
+	if err != nil {
+		return err
+	}
+	s.db = db
+
+	s.log = zap.L().Named(s.Name())
+	s.encryptKey = conf.C().App.EncryptKey
+	return nil
+}
+
+func (s *service) Name() string {
+	return secret.AppName
+}
+
+func (s *service) Registry(server *grpc.Server) {
+	secret.RegisterServiceServer(server, svr)
+}
+
+func init() {
+	app.RegistryGrpcApp(svr)
+}
@@ -0,0 +1,19 @@
+package impl
+
+const (
+	sqlInsertSecret = `INSERT INTO secret (
+		id,create_at,description,vendor,address,allow_regions,crendential_type,api_key,api_secret,request_rate
+	) VALUES (?,?,?,?,?,?,?,?,?,?);`
+
+	sqlQuerySecret = `SELECT 
+		id,create_at,description,vendor,address,allow_regions,crendential_type,api_key,api_secret,request_rate 
+	FROM secret`
+
+	sqlCountSecret = `SELECT COUNT(*) FROM secret`
+
+	sqlUpdateSecret = `UPDATE secret SET 
+		description=?,vendor=?,address=?,allow_regions=?,crendential_type=?,api_key=?,api_secret=?,request_rate=? 
+	WHERE id = ?`
+
+	sqlDeleteSecret = `DELETE FROM secret WHERE id = ?`
+)
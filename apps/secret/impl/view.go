@@ -0,0 +1,153 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/request"
+
+	"github.com/opengoats/cmdb/apps/secret"
+)
+
+func (s *service) CreateSecret(ctx context.Context, req *secret.CreateSecretRequest) (*secret.Secret, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("CreateSecret").Error(err)
+		return nil, exception.NewBadRequest("validate create secret error, %s", err)
+	}
+
+	// secret加密后入库
+	ins := secret.NewSecret(req)
+	if err := ins.EncryptAPISecret(s.encryptKey); err != nil {
+		s.log.Named("CreateSecret").Error(err)
+		return nil, exception.NewInternalServerError("encrypt secret error, %s", err)
+	}
+	if err := s.save(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+func (s *service) QuerySecret(ctx context.Context, req *secret.QuerySecretRequest) (*secret.SecretSet, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("QuerySecret").Error(err)
+		return nil, exception.NewBadRequest("validate query secret error, %s", err)
+	}
+
+	// 分页默认值填充
+	if req.Page == nil {
+		req.Page = request.NewDefaultPageRequest()
+	}
+	if req.Page.PageSize == 0 {
+		req.Page.PageSize = request.DefaultPageSize
+	}
+	if req.Page.PageNumber == 0 {
+		req.Page.PageNumber = request.DefaultPageNumber
+	}
+
+	// 数据库查询
+	set, err := s.query(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	set.Desensitize()
+	return set, nil
+}
+
+func (s *service) DescribeSecret(ctx context.Context, req *secret.DescribeSecretRequest) (*secret.Secret, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("DescribeSecret").Error(err)
+		return nil, exception.NewBadRequest("validate describe secret error, %s", err)
+	}
+
+	// 数据库查询
+	ins, err := s.describe(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+func (s *service) UpdateSecret(ctx context.Context, req *secret.UpdateSecretRequest) (*secret.Secret, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("UpdateSecret").Error(err)
+		return nil, exception.NewBadRequest("validate update secret error, %s", err)
+	}
+
+	// 验证更新id,查询不到直接返回
+	ins, err := s.describe(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 合并更新数据, 新的secret会重新加密
+	if err := ins.Update(req, s.encryptKey); err != nil {
+		s.log.Named("UpdateSecret").Error(err)
+		return nil, exception.NewInternalServerError("update secret error, %s", err)
+	}
+
+	// 校验更新后数据合法性
+	if err := ins.Validate(); err != nil {
+		s.log.Named("UpdateSecret").Error(err)
+		return nil, exception.NewBadRequest("validate update secret error, %s", err)
+	}
+
+	// 数据库更新
+	if err := s.update(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+func (s *service) DeleteSecret(ctx context.Context, req *secret.DeleteSecretRequest) (*secret.Secret, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("DeleteSecret").Error(err)
+		return nil, exception.NewBadRequest("validate delete secret error, %s", err)
+	}
+
+	// 验证删除id,查询不到直接返回
+	ins, err := s.describe(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 数据库删除
+	if err := s.delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+func (s *service) DecryptSecret(ctx context.Context, req *secret.DescribeSecretRequest) (*secret.Secret, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("DecryptSecret").Error(err)
+		return nil, exception.NewBadRequest("validate describe secret error, %s", err)
+	}
+
+	// 数据库查询
+	ins, err := s.describe(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解密secret
+	if err := ins.DecryptAPISecret(s.encryptKey); err != nil {
+		s.log.Named("DecryptSecret").Error(err)
+		return nil, exception.NewInternalServerError("decrypt secret error, %s", err)
+	}
+	return ins, nil
+}
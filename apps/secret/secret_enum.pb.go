@@ -0,0 +1,55 @@
+// Code generated by github.com/opengoats/goat
+// DO NOT EDIT
+
+package secret
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseCredentialTypeFromString Parse CredentialType from string
+func ParseCredentialTypeFromString(str string) (CredentialType, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := CredentialType_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown CredentialType: %s", str)
+	}
+
+	return CredentialType(v), nil
+}
+
+// Equal type compare
+func (t CredentialType) Equal(target CredentialType) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t CredentialType) IsIn(targets ...CredentialType) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t CredentialType) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *CredentialType) UnmarshalJSON(b []byte) error {
+	ins, err := ParseCredentialTypeFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
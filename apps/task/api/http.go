@@ -0,0 +1,69 @@
+package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/task"
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/http/response"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service task.ServiceServer
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(task.AppName)
+	h.service = app.GetGrpcApp(task.AppName).(task.ServiceServer)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return task.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"tasks"}
+
+	ws.Route(ws.POST("").To(h.CreateTask).
+		Doc("create a sync task").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(task.CreateTaskRequest{}).
+		Writes(response.NewMessage(task.Task{})).
+		Returns(200, "OK", response.NewMessage(task.Task{})).
+		Returns(429, "Too Many Running Tasks", nil))
+
+	ws.Route(ws.GET("/").To(h.QueryTask).
+		Doc("get all tasks").
+		Param(ws.QueryParameter("secret_id", "secret id").DataType("string")).
+		Param(ws.QueryParameter("region", "region").DataType("string")).
+		Param(ws.QueryParameter("resource_type", "resource type").DataType("string")).
+		Param(ws.QueryParameter("status", "task status").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Metadata("action", "list").
+		Writes(response.NewMessage(task.TaskSet{})).
+		Returns(200, "OK", task.TaskSet{}))
+
+	ws.Route(ws.GET("/{id}").To(h.DescribeTask).
+		Doc("get a task").
+		Param(ws.PathParameter("id", "identifier of the task").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(response.NewMessage(task.Task{})).
+		Returns(200, "OK", response.NewMessage(task.Task{})).
+		Returns(404, "Not Found", nil))
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/cmdb/apps/task"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/response"
+)
+
+func (h *handler) CreateTask(r *restful.Request, w *restful.Response) {
+	req := task.NewCreateTaskRequest()
+
+	if err := r.ReadEntity(req); err != nil {
+		h.log.Named("CreateTask").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("read task error, %s", err))
+		return
+	}
+
+	ins, err := h.service.CreateTask(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("CreateTask").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+	response.Success(w.ResponseWriter, ins)
+}
+
+func (h *handler) QueryTask(r *restful.Request, w *restful.Response) {
+	// 加载查询条件
+	req, err := task.NewQueryTaskRequestFromHTTP(r.Request)
+	if err != nil {
+		h.log.Named("QueryTask").Error(err)
+		response.Failed(w.ResponseWriter, exception.NewBadRequest("parse query task request error, %s", err))
+		return
+	}
+
+	// 数据查询
+	set, err := h.service.QueryTask(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("QueryTask").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, set)
+}
+
+func (h *handler) DescribeTask(r *restful.Request, w *restful.Response) {
+	req := task.NewDescribeTaskRequest(r.PathParameter("id"))
+	ins, err := h.service.DescribeTask(r.Request.Context(), req)
+	if err != nil {
+		h.log.Named("DescribeTask").Error(err)
+		response.Failed(w.ResponseWriter, err)
+		return
+	}
+
+	response.Success(w.ResponseWriter, ins)
+}
@@ -0,0 +1,5 @@
+package task
+
+const (
+	AppName = "task"
+)
@@ -0,0 +1,168 @@
+package task
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/opengoats/goat/http/request"
+	"github.com/rs/xid"
+
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+const (
+	// DefaultTimeout 默认任务超时时间, 单位秒
+	DefaultTimeout = 30 * 60
+)
+
+var (
+	validate = validator.New()
+)
+
+func (r *CreateTaskRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func (r *QueryTaskRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func (r *DescribeTaskRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	} else {
+		return nil
+	}
+}
+
+func NewCreateTaskRequest() *CreateTaskRequest {
+	return &CreateTaskRequest{
+		Timeout: DefaultTimeout,
+	}
+}
+
+// TimeoutDuration 任务超时时间
+func (r *CreateTaskRequest) TimeoutDuration() time.Duration {
+	if r.Timeout == 0 {
+		return DefaultTimeout * time.Second
+	}
+	return time.Duration(r.Timeout) * time.Second
+}
+
+func NewDefaultTask() *Task {
+	return &Task{
+		Data: NewCreateTaskRequest(),
+	}
+}
+
+func NewTask(req *CreateTaskRequest) *Task {
+	if req.Timeout == 0 {
+		req.Timeout = DefaultTimeout
+	}
+	return &Task{
+		Id:      xid.New().String(),
+		Data:    req,
+		Status:  Status_PENDING,
+		StartAt: time.Now().UnixMilli(),
+	}
+}
+
+// Actor 同步任务作为资源的录入人和更新人, 用于追溯资源的变更来源
+func (t *Task) Actor() string {
+	return "task:" + t.Id
+}
+
+// Run 任务开始执行
+func (t *Task) Run() {
+	t.Status = Status_RUNNING
+}
+
+// AddResult 根据资源的保存结果更新计数
+func (t *Task) AddResult(r *resource.SaveResult) {
+	if r.Status.Equal(resource.SaveStatus_FAILED) {
+		t.TotalFailed++
+	} else {
+		t.TotalSucceed++
+	}
+}
+
+// Completed 任务执行完成, 有资源保存失败时状态为WARNING
+func (t *Task) Completed() {
+	t.EndAt = time.Now().UnixMilli()
+	if t.TotalFailed > 0 {
+		t.Status = Status_WARNING
+		t.Message = fmt.Sprintf("%d resources save failed", t.TotalFailed)
+		return
+	}
+	t.Status = Status_SUCCESS
+}
+
+// Failed 任务执行失败
+func (t *Task) Failed(format string, a ...interface{}) {
+	t.EndAt = time.Now().UnixMilli()
+	t.Status = Status_FAILED
+	t.Message = fmt.Sprintf(format, a...)
+}
+
+// IsFinished 任务是否已经结束
+func (t *Task) IsFinished() bool {
+	return t.EndAt > 0
+}
+
+func NewTaskSet() *TaskSet {
+	return &TaskSet{
+		Items: []*Task{},
+	}
+}
+
+func (s *TaskSet) Add(item *Task) {
+	s.Items = append(s.Items, item)
+}
+
+func NewQueryTaskRequest() *QueryTaskRequest {
+	return &QueryTaskRequest{
+		Page: request.NewDefaultPageRequest(),
+	}
+}
+
+func NewQueryTaskRequestFromHTTP(r *http.Request) (*QueryTaskRequest, error) {
+	qs := r.URL.Query()
+
+	req := &QueryTaskRequest{
+		Page:     request.NewPageRequestFromHTTP(r),
+		SecretId: qs.Get("secret_id"),
+		Region:   qs.Get("region"),
+	}
+	if rt := qs.Get("resource_type"); rt != "" {
+		v, err := resource.ParseTypeFromString(rt)
+		if err != nil {
+			return nil, err
+		}
+		req.ResourceType = &v
+	}
+	if st := qs.Get("status"); st != "" {
+		v, err := ParseStatusFromString(st)
+		if err != nil {
+			return nil, err
+		}
+		req.Status = &v
+	}
+
+	return req, nil
+}
+
+func NewDescribeTaskRequest(id string) *DescribeTaskRequest {
+	return &DescribeTaskRequest{
+		Id: id,
+	}
+}
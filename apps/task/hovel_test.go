@@ -0,0 +1,56 @@
+package task_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/apps/task"
+)
+
+func TestTaskLifecycle(t *testing.T) {
+	should := assert.New(t)
+
+	req := task.NewCreateTaskRequest()
+	req.Timeout = 0
+	ins := task.NewTask(req)
+	should.Equal(task.Status_PENDING, ins.Status)
+	should.Equal(int64(task.DefaultTimeout), ins.Data.Timeout)
+
+	ins.Run()
+	should.Equal(task.Status_RUNNING, ins.Status)
+	should.False(ins.IsFinished())
+
+	ins.AddResult(&resource.SaveResult{Status: resource.SaveStatus_CREATED})
+	ins.AddResult(&resource.SaveResult{Status: resource.SaveStatus_UNCHANGED})
+	ins.Completed()
+	should.Equal(task.Status_SUCCESS, ins.Status)
+	should.Equal(int64(2), ins.TotalSucceed)
+	should.True(ins.IsFinished())
+
+	ins = task.NewTask(task.NewCreateTaskRequest())
+	ins.AddResult(&resource.SaveResult{Status: resource.SaveStatus_FAILED})
+	ins.Completed()
+	should.Equal(task.Status_WARNING, ins.Status)
+	should.Equal(int64(1), ins.TotalFailed)
+
+	ins.Failed("task timeout after %s", ins.Data.TimeoutDuration())
+	should.Equal(task.Status_FAILED, ins.Status)
+	should.Equal("task timeout after 30m0s", ins.Message)
+}
+
+func TestNewQueryTaskRequestFromHTTP(t *testing.T) {
+	should := assert.New(t)
+
+	req, err := task.NewQueryTaskRequestFromHTTP(httptest.NewRequest("GET", "/?resource_type=host&status=running&region=cn-hangzhou", nil))
+	if should.NoError(err) {
+		should.Equal(resource.Type_HOST, *req.ResourceType)
+		should.Equal(task.Status_RUNNING, *req.Status)
+		should.Equal("cn-hangzhou", req.Region)
+	}
+
+	_, err = task.NewQueryTaskRequestFromHTTP(httptest.NewRequest("GET", "/?status=unknown", nil))
+	should.Error(err)
+}
@@ -0,0 +1,193 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/sqlbuilder"
+
+	"github.com/opengoats/cmdb/apps/task"
+)
+
+func (s *service) save(ctx context.Context, ins *task.Task) error {
+	s.log.Named("CreateTask").Debugf("sql: %s", sqlInsertTask)
+	stmt, err := s.db.PrepareContext(ctx, sqlInsertTask)
+	if err != nil {
+		s.log.Named("CreateTask").Error(err)
+		return exception.NewInternalServerError("insert task err %s", err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		ins.Id, ins.Data.Region, ins.Data.ResourceType, ins.Data.SecretId, ins.SecretDescription, ins.Data.Timeout,
+		ins.Status, ins.Message, ins.StartAt, ins.EndAt, ins.TotalSucceed, ins.TotalFailed, ins.TotalReleased,
+		ins.StartAt,
+	)
+	if err != nil {
+		s.log.Named("CreateTask").Error(err)
+		return exception.NewInternalServerError("insert task err %s", err)
+	}
+
+	return nil
+}
+
+// update 更新任务的状态和计数, 同时刷新心跳
+func (s *service) update(ctx context.Context, ins *task.Task) error {
+	s.log.Named("RunTask").Debugf("sql: %s", sqlUpdateTask)
+	stmt, err := s.db.PrepareContext(ctx, sqlUpdateTask)
+	if err != nil {
+		s.log.Named("RunTask").Error(err)
+		return exception.NewInternalServerError("update task err %s", err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		ins.Status, ins.Message, ins.EndAt, ins.TotalSucceed, ins.TotalFailed, ins.TotalReleased, time.Now().UnixMilli(),
+		ins.Id,
+	)
+	if err != nil {
+		s.log.Named("RunTask").Error(err)
+		return exception.NewInternalServerError("update task err %s", err)
+	}
+
+	return nil
+}
+
+// heartbeat 执行中的任务定期刷新心跳, 表示执行任务的实例仍然存活
+func (s *service) heartbeat(ctx context.Context, id string) error {
+	s.log.Named("RunTask").Debugf("sql: %s", sqlHeartbeatTask)
+	_, err := s.db.ExecContext(ctx, sqlHeartbeatTask, time.Now().UnixMilli(), id, task.Status_PENDING, task.Status_RUNNING)
+	if err != nil {
+		return exception.NewInternalServerError("heartbeat task err %s", err)
+	}
+	return nil
+}
+
+// failStale 执行任务的实例退出后心跳不再刷新, 心跳超时的任务不会再继续执行, 标记为失败
+func (s *service) failStale(ctx context.Context) error {
+	now := time.Now()
+	s.log.Named("FailStaleTask").Debugf("sql: %s", sqlFailStaleTask)
+	result, err := s.db.ExecContext(ctx, sqlFailStaleTask,
+		task.Status_FAILED, "task interrupted, heartbeat timeout", now.UnixMilli(),
+		task.Status_PENDING, task.Status_RUNNING, now.Add(-staleTimeout).UnixMilli(),
+	)
+	if err != nil {
+		s.log.Named("FailStaleTask").Error(err)
+		return exception.NewInternalServerError("fail stale task err %s", err)
+	}
+
+	if n, _ := result.RowsAffected(); n > 0 {
+		s.log.Named("FailStaleTask").Warnf("%d stale tasks marked as failed", n)
+	}
+	return nil
+}
+
+func (s *service) query(ctx context.Context, req *task.QueryTaskRequest) (*task.TaskSet, error) {
+	query := sqlbuilder.NewQuery(sqlQueryTask)
+	if req.SecretId != "" {
+		query.Where("secret_id = ?", req.SecretId)
+	}
+	if req.Region != "" {
+		query.Where("region = ?", req.Region)
+	}
+	if req.ResourceType != nil {
+		query.Where("resource_type = ?", *req.ResourceType)
+	}
+	if req.Status != nil {
+		query.Where("status = ?", *req.Status)
+	}
+
+	set := task.NewTaskSet()
+
+	// 获取total
+	countSQL, args := query.BuildFromNewBase(sqlCountTask)
+	s.log.Named("QueryTask").Debugf("sql: %s; %v", countSQL, args)
+	countStmt, err := s.db.PrepareContext(ctx, countSQL)
+	if err != nil {
+		s.log.Named("QueryTask").Error(err)
+		return nil, exception.NewInternalServerError("count task err %s", err)
+	}
+	defer countStmt.Close()
+
+	err = countStmt.QueryRowContext(ctx, args...).Scan(&set.Total)
+	if err != nil {
+		s.log.Named("QueryTask").Error(err)
+		return nil, exception.NewInternalServerError("count task err %s", err)
+	}
+
+	// 获取分页数据
+	querySQL, args := query.Order("start_at").Desc().
+		Limit(req.Page.ComputeOffset(), uint(req.Page.PageSize)).Build()
+	s.log.Named("QueryTask").Debugf("sql: %s; %v", querySQL, args)
+	queryStmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("QueryTask").Error(err)
+		return nil, exception.NewInternalServerError("query task err %s", err)
+	}
+	defer queryStmt.Close()
+
+	rows, err := queryStmt.QueryContext(ctx, args...)
+	if err != nil {
+		s.log.Named("QueryTask").Error(err)
+		return nil, exception.NewInternalServerError("query task err %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ins, err := scanTask(rows)
+		if err != nil {
+			s.log.Named("QueryTask").Error(err)
+			return nil, exception.NewInternalServerError("query task err %s", err)
+		}
+		set.Add(ins)
+	}
+	if err := rows.Err(); err != nil {
+		s.log.Named("QueryTask").Error(err)
+		return nil, exception.NewInternalServerError("query task err %s", err)
+	}
+
+	return set, nil
+}
+
+func (s *service) describe(ctx context.Context, id string) (*task.Task, error) {
+	query := sqlbuilder.NewQuery(sqlQueryTask).Where("id = ?", id)
+
+	querySQL, args := query.Build()
+	s.log.Named("DescribeTask").Debugf("sql: %s; %v", querySQL, args)
+	stmt, err := s.db.PrepareContext(ctx, querySQL)
+	if err != nil {
+		s.log.Named("DescribeTask").Error(err)
+		return nil, exception.NewInternalServerError("describe task err %s", err)
+	}
+	defer stmt.Close()
+
+	ins, err := scanTask(stmt.QueryRowContext(ctx, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, exception.NewNotFound("task %s not found", id)
+		}
+		s.log.Named("DescribeTask").Error(err)
+		return nil, exception.NewInternalServerError("describe task err %s", err)
+	}
+
+	return ins, nil
+}
+
+// 字段顺序与sqlQueryTask保持一致
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanTask(row scanner) (*task.Task, error) {
+	ins := task.NewDefaultTask()
+	err := row.Scan(
+		&ins.Id, &ins.Data.Region, &ins.Data.ResourceType, &ins.Data.SecretId, &ins.SecretDescription, &ins.Data.Timeout,
+		&ins.Status, &ins.Message, &ins.StartAt, &ins.EndAt, &ins.TotalSucceed, &ins.TotalFailed, &ins.TotalReleased,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
@@ -0,0 +1,98 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/logger"
+	"github.com/opengoats/goat/logger/zap"
+	"google.golang.org/grpc"
+
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/apps/secret"
+	"github.com/opengoats/cmdb/apps/task"
+	"github.com/opengoats/cmdb/conf"
+)
+
+var (
+	// Service 服务实例
+	svr = &service{}
+)
+
+type service struct {
+	db       *sql.DB
+	log      logger.Logger
+	secret   secret.Service
+	resource resource.Service
+	host     host.ServiceServer
+	// 执行名额, 限制同时执行的任务数量
+	running chan struct{}
+	task.UnimplementedServiceServer
+}
+
+func (s *service) Config() error {
+	db, err := conf.C().MySQL.GetDB()
+	if err != nil {
+		return err
+	}
+	s.db = db
+
+	s.log = zap.L().Named(s.Name())
+	// 同步任务需要使用解密后的凭证
+	s.secret = app.GetGrpcApp(secret.AppName).(secret.Service)
+	// 同步的资源通过资源服务和主机服务保存
+	s.resource = app.GetGrpcApp(resource.AppName).(resource.Service)
+	s.host = app.GetGrpcApp(host.AppName).(host.ServiceServer)
+
+	maxRunning := conf.C().Task.MaxRunning
+	if maxRunning <= 0 {
+		maxRunning = 1
+	}
+	s.running = make(chan struct{}, maxRunning)
+
+	// 其他实例(包括上次退出的本实例)没有执行完成的任务, 心跳超时后标记为失败
+	if err := s.failStale(context.Background()); err != nil {
+		return err
+	}
+	go s.watchStale()
+	return nil
+}
+
+// watchStale 定期检查心跳超时的任务
+func (s *service) watchStale() {
+	tk := time.NewTicker(heartbeatInterval)
+	defer tk.Stop()
+
+	for range tk.C {
+		_ = s.failStale(context.Background())
+	}
+}
+
+// acquire 占用一个执行名额, 名额已满时返回false
+func (s *service) acquire() bool {
+	select {
+	case s.running <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *service) release() {
+	<-s.running
+}
+
+func (s *service) Name() string {
+	return task.AppName
+}
+
+func (s *service) Registry(server *grpc.Server) {
+	task.RegisterServiceServer(server, svr)
+}
+
+func init() {
+	app.RegistryGrpcApp(svr)
+}
@@ -0,0 +1,148 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/apps/secret"
+	"github.com/opengoats/cmdb/apps/task"
+)
+
+const (
+	// 每保存多少个资源同步一次任务计数
+	flushBatchSize = 50
+	// 执行中的任务刷新心跳的间隔
+	heartbeatInterval = 30 * time.Second
+	// 心跳超过该时间没有刷新时, 认为执行任务的实例已经退出
+	staleTimeout = 3 * heartbeatInterval
+)
+
+// runner 执行一个同步任务, 同步过程中的回调和超时处理会并发访问任务, 需要加锁
+type runner struct {
+	svc     *service
+	ins     *task.Task
+	lock    sync.Mutex
+	pending int
+}
+
+// run 调用方需要先通过acquire占用一个执行名额, 同步退出后释放
+func (s *service) run(ins *task.Task) {
+	r := &runner{svc: s, ins: ins}
+
+	// 任务在后台执行, 不使用请求的上下文
+	ctx, cancel := context.WithTimeout(context.Background(), ins.Data.TimeoutDuration())
+	defer cancel()
+	go r.heartbeat(ctx)
+
+	r.lock.Lock()
+	ins.Run()
+	r.flush()
+	r.lock.Unlock()
+
+	// 超时后同步可能还在保存资源, 执行名额需要等同步退出后才释放
+	done := make(chan error, 1)
+	go func() {
+		defer s.release()
+		done <- r.sync(ctx)
+	}()
+
+	// 同步的实现没有及时响应ctx时, 同样按照超时处理
+	select {
+	case err := <-done:
+		r.lock.Lock()
+		if err != nil {
+			ins.Failed("%s", err)
+		} else {
+			ins.Completed()
+		}
+	case <-ctx.Done():
+		r.lock.Lock()
+		ins.Failed("task timeout after %s", ins.Data.TimeoutDuration())
+	}
+	r.flush()
+	r.lock.Unlock()
+}
+
+// heartbeat 任务结束(ctx取消)前定期刷新心跳
+func (r *runner) heartbeat(ctx context.Context) {
+	tk := time.NewTicker(heartbeatInterval)
+	defer tk.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tk.C:
+			if err := r.svc.heartbeat(ctx, r.ins.Id); err != nil {
+				r.svc.log.Named("RunTask").Errorf("heartbeat task %s error, %s", r.ins.Id, err)
+			}
+		}
+	}
+}
+
+func (r *runner) sync(ctx context.Context) error {
+	sec, err := r.svc.secret.DecryptSecret(ctx, secret.NewDescribeSecretRequest(r.ins.Data.SecretId))
+	if err != nil {
+		return err
+	}
+	if !sec.Data.IsAllowRegion(r.ins.Data.Region) {
+		return fmt.Errorf("region %s not allowed by secret %s", r.ins.Data.Region, sec.Id)
+	}
+
+	if err := r.svc.sync(ctx, sec, r.ins, r.onResult); err != nil {
+		return err
+	}
+
+	return r.release(ctx)
+}
+
+// release 完整同步之后, 释放本次没有同步到的资源, 有资源保存失败时不能判断哪些资源已经删除
+func (r *runner) release(ctx context.Context) error {
+	r.lock.Lock()
+	failed := r.ins.TotalFailed
+	r.lock.Unlock()
+	if failed > 0 {
+		r.svc.log.Named("RunTask").Warnf("task %s has %d resources save failed, skip release", r.ins.Id, failed)
+		return nil
+	}
+
+	req := resource.NewReleaseResourcesRequest(r.ins.Data.SecretId, r.ins.Data.Region, r.ins.Data.ResourceType, r.ins.StartAt)
+	req.DeleteBy = r.ins.Id
+	result, err := r.svc.resource.ReleaseResources(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	r.ins.TotalReleased = result.Total
+	r.lock.Unlock()
+	return nil
+}
+
+// onResult 每保存一个资源回调一次, 按批次更新任务计数
+func (r *runner) onResult(result *resource.SaveResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// 任务已经超时结束, 忽略后续的结果
+	if r.ins.IsFinished() {
+		return
+	}
+
+	r.ins.AddResult(result)
+	r.pending++
+	if r.pending >= flushBatchSize {
+		r.flush()
+	}
+}
+
+// flush 把任务的状态和计数写入数据库, 调用方需要持有锁
+func (r *runner) flush() {
+	r.pending = 0
+	if err := r.svc.update(context.Background(), r.ins); err != nil {
+		r.svc.log.Named("RunTask").Errorf("update task %s error, %s", r.ins.Id, err)
+	}
+}
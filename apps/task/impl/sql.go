@@ -0,0 +1,24 @@
+package impl
+
+const (
+	sqlInsertTask = `INSERT INTO task (
+		id,region,resource_type,secret_id,secret_desc,timeout,status,message,start_at,end_at,total_succeed,total_failed,
+		total_released,heartbeat_at
+	) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?);`
+
+	sqlUpdateTask = `UPDATE task SET 
+		status=?,message=?,end_at=?,total_succeed=?,total_failed=?,total_released=?,heartbeat_at=? 
+	WHERE id = ?`
+
+	sqlHeartbeatTask = `UPDATE task SET heartbeat_at=? WHERE id = ? AND status IN (?,?)`
+
+	sqlQueryTask = `SELECT 
+		id,region,resource_type,secret_id,secret_desc,timeout,status,message,start_at,end_at,total_succeed,total_failed,
+		total_released 
+	FROM task`
+
+	sqlCountTask = `SELECT COUNT(*) FROM task`
+
+	// 多个实例共用任务表, 只把心跳超时的等待执行和执行中的任务标记为失败
+	sqlFailStaleTask = `UPDATE task SET status=?,message=?,end_at=? WHERE status IN (?,?) AND heartbeat_at < ?`
+)
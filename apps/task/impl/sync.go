@@ -0,0 +1,92 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/apps/secret"
+	"github.com/opengoats/cmdb/apps/task"
+	"github.com/opengoats/cmdb/provider"
+)
+
+// sync 按凭证同步一个Region下某种类型的资源, 每保存一个资源通过cb回调一次
+func (s *service) sync(ctx context.Context, sec *secret.Secret, ins *task.Task, cb func(*resource.SaveResult)) error {
+	req := ins.Data
+	p, err := provider.Get(sec.Data.Vendor, req.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	return p.Query(ctx, provider.NewQueryRequest(sec, req.Region), func(page []*provider.Item) error {
+		// 任务超时后不再保存剩余的资源
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		for i := range page {
+			fillSyncInfo(page[i].Resource, sec, ins)
+		}
+
+		switch req.ResourceType {
+		case resource.Type_HOST:
+			return s.saveHosts(ctx, page, cb)
+		default:
+			return s.saveResources(ctx, page, cb)
+		}
+	})
+}
+
+// fillSyncInfo 同步过来的资源以凭证和任务的信息为准, 录入人和更新人为同步任务
+func fillSyncInfo(r *resource.Resource, sec *secret.Secret, ins *task.Task) {
+	r.Vendor = sec.Data.Vendor
+	r.ResourceType = ins.Data.ResourceType
+	r.SecretId = sec.Id
+	r.SyncAccount = sec.Data.ApiKey
+	if r.Region == "" {
+		r.Region = ins.Data.Region
+	}
+	r.CreateBy = ins.Actor()
+	r.UpdateBy = ins.Actor()
+}
+
+func (s *service) saveHosts(ctx context.Context, page []*provider.Item, cb func(*resource.SaveResult)) error {
+	for i := range page {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		d, ok := page[i].Detail.(*host.Describe)
+		if !ok {
+			return fmt.Errorf("host %s detail type %T not *host.Describe", page[i].Resource.Cid, page[i].Detail)
+		}
+
+		req := &host.Host{Resource: page[i].Resource, Describe: d}
+		result, err := s.host.SaveHost(ctx, req)
+		if err != nil {
+			result = resource.NewSaveResult(resource.NewSaveRequest(req.Resource))
+			result.Failed("%s", err)
+		}
+		cb(result)
+	}
+	return nil
+}
+
+func (s *service) saveResources(ctx context.Context, page []*provider.Item, cb func(*resource.SaveResult)) error {
+	req := resource.NewBatchSaveRequest()
+	for i := range page {
+		item := resource.NewSaveRequest(page[i].Resource)
+		if d, ok := page[i].Detail.(map[string]string); ok {
+			item.Describe = d
+		}
+		req.Items = append(req.Items, item)
+	}
+
+	set, err := s.resource.BatchSave(ctx, req)
+	if err != nil {
+		return err
+	}
+	for i := range set.Items {
+		cb(set.Items[i])
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/request"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/opengoats/cmdb/apps/secret"
+	"github.com/opengoats/cmdb/apps/task"
+)
+
+func (s *service) CreateTask(ctx context.Context, req *task.CreateTaskRequest) (*task.Task, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("CreateTask").Error(err)
+		return nil, exception.NewBadRequest("validate create task error, %s", err)
+	}
+
+	// 验证凭证, 查询不到直接返回
+	sec, err := s.secret.DescribeSecret(ctx, secret.NewDescribeSecretRequest(req.SecretId))
+	if err != nil {
+		return nil, err
+	}
+	if !sec.Data.IsAllowRegion(req.Region) {
+		return nil, exception.NewBadRequest("region %s not allowed by secret %s", req.Region, sec.Id)
+	}
+
+	// 同时执行的任务数量超过上限时直接拒绝, 由调用方稍后重试
+	if !s.acquire() {
+		return nil, exception.NewAPIException("", http.StatusTooManyRequests, "任务过多",
+			"too many running tasks, max %d", cap(s.running))
+	}
+
+	ins := task.NewTask(req)
+	ins.SecretDescription = sec.Data.Description
+	if err := s.save(ctx, ins); err != nil {
+		s.release()
+		return nil, err
+	}
+
+	// 后台异步执行, 返回的是任务创建时的快照
+	resp := proto.Clone(ins).(*task.Task)
+	go s.run(ins)
+	return resp, nil
+}
+
+func (s *service) QueryTask(ctx context.Context, req *task.QueryTaskRequest) (*task.TaskSet, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("QueryTask").Error(err)
+		return nil, exception.NewBadRequest("validate query task error, %s", err)
+	}
+
+	// 分页默认值填充
+	if req.Page == nil {
+		req.Page = request.NewDefaultPageRequest()
+	}
+	if req.Page.PageSize == 0 {
+		req.Page.PageSize = request.DefaultPageSize
+	}
+	if req.Page.PageNumber == 0 {
+		req.Page.PageNumber = request.DefaultPageNumber
+	}
+
+	// 数据库查询
+	return s.query(ctx, req)
+}
+
+func (s *service) DescribeTask(ctx context.Context, req *task.DescribeTaskRequest) (*task.Task, error) {
+	// 请求体校验
+	if err := req.Validate(); err != nil {
+		s.log.Named("DescribeTask").Error(err)
+		return nil, exception.NewBadRequest("validate describe task error, %s", err)
+	}
+
+	// 数据库查询
+	return s.describe(ctx, req.Id)
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/opengoats/goat/app"
+	"github.com/spf13/cobra"
+
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/idc"
+	"github.com/opengoats/cmdb/apps/resource"
+)
+
+var (
+	importFile     string
+	importFormat   string
+	importDryRun   bool
+	importCreateBy string
+	importTimeout  time.Duration
+)
+
+// importCmd 从文件导入IDC资源
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "从CSV或者JSON文件导入IDC资源",
+	Long:  "从CSV或者JSON文件导入IDC资源, 主机和标签, 按c_id新建或者更新, 输出每一行的导入结果",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if importFile == "" {
+			return fmt.Errorf("import file required")
+		}
+		req, err := newImportRequest()
+		if err != nil {
+			return err
+		}
+
+		// 初始化全局变量
+		if err := loadGlobalConfig(confType); err != nil {
+			return err
+		}
+		if err := loadGlobalLogger(); err != nil {
+			return err
+		}
+
+		// 只初始化导入依赖的服务, 不启动同步任务等后台服务
+		for _, name := range []string{resource.AppName, host.AppName, idc.AppName} {
+			if err := app.GetGrpcApp(name).Config(); err != nil {
+				return err
+			}
+		}
+
+		ctx, cancelfunc := context.WithTimeout(context.Background(), importTimeout)
+		defer cancelfunc()
+		report, err := app.GetGrpcApp(idc.AppName).(idc.ServiceServer).ImportResources(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		printImportReport(report)
+		if report.Failed > 0 {
+			return fmt.Errorf("%d rows import failed", report.Failed)
+		}
+		return nil
+	},
+}
+
+// newImportRequest 没有指定格式时按文件扩展名判断
+func newImportRequest() (*idc.ImportRequest, error) {
+	data, err := os.ReadFile(importFile)
+	if err != nil {
+		return nil, err
+	}
+
+	format := importFormat
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(importFile), ".")
+	}
+	f, err := idc.ParseImportFormatFromString(format)
+	if err != nil {
+		return nil, err
+	}
+
+	req := idc.NewImportRequest(f, data)
+	req.DryRun = importDryRun
+	req.CreateBy = importCreateBy
+	return req, nil
+}
+
+func printImportReport(report *idc.ImportReport) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LINE\tC_ID\tTYPE\tID\tSTATUS\tMESSAGE")
+	for _, item := range report.Items {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			item.Line, item.Cid, strings.ToUpper(item.ResourceType.String()), item.Id, item.Status, item.Message)
+	}
+	w.Flush()
+
+	mode := ""
+	if report.DryRun {
+		mode = "(dry run) "
+	}
+	fmt.Printf("\n%stotal: %d, created: %d, updated: %d, unchanged: %d, failed: %d\n",
+		mode, report.Total, report.Created, report.Updated, report.Unchanged, report.Failed)
+}
+
+func init() {
+	importCmd.PersistentFlags().StringVarP(&importFile, "input", "i", "", "the csv or json file to import")
+	importCmd.PersistentFlags().StringVar(&importFormat, "format", "", "the import file format [csv/json], default by file extension")
+	importCmd.PersistentFlags().BoolVar(&importDryRun, "dry-run", false, "only validate and compare, write nothing")
+	importCmd.PersistentFlags().StringVar(&importCreateBy, "create-by", "cli", "the operator recorded as create_by")
+	importCmd.PersistentFlags().DurationVar(&importTimeout, "timeout", 10*time.Minute, "the import timeout")
+	RootCmd.AddCommand(importCmd)
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/opengoats/cmdb/conf"
+)
+
+var (
+	retentionDays      int
+	eventRetentionDays int
+	purgeTimeout       time.Duration
+)
+
+const (
+	// 已经释放的资源关联的数据, 资源需要最后删除
+	sqlPurgeReleased = `DELETE x FROM %s x INNER JOIN resource r ON r.id = x.resource_id 
+	WHERE r.status = 0 AND r.delete_at > 0 AND r.delete_at < ?`
+	sqlPurgeReleasedResource = `DELETE FROM resource WHERE status = 0 AND delete_at > 0 AND delete_at < ?`
+	// 超过事件保留时间的变更事件, 与资源的保留时间无关, 订阅方无法再从这些事件之前的版本号继续订阅
+	sqlPurgeEvent = `DELETE FROM resource_event WHERE create_at < ?`
+)
+
+var (
+	// 资源关联的数据表, 账单作为财务记录保留
+	purgeTables = []string{"resource_tag", "resource_ip", "resource_host", "resource_revision", "resource_reminder"}
+)
+
+// purgeCmd 清理已经释放的资源
+var purgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "清理已经释放超过保留时间的资源",
+	Long:  "清理已经释放(厂商侧已经删除)超过保留时间的资源, 以及资源的标签, IP, 主机信息, 变更历史和续费提醒记录; 同时清理超过事件保留时间的变更事件",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if retentionDays < 0 {
+			return fmt.Errorf("retention days must be greater than or equal to 0")
+		}
+		if eventRetentionDays < 0 {
+			return fmt.Errorf("event retention days must be greater than or equal to 0")
+		}
+
+		// 初始化全局变量
+		if err := loadGlobalConfig(confType); err != nil {
+			return err
+		}
+
+		n, err := purgeReleased(time.Now().AddDate(0, 0, -retentionDays))
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("清理了 %d 个释放超过 %d 天的资源\n", n, retentionDays)
+
+		n, err = purgeEvents(time.Now().AddDate(0, 0, -eventRetentionDays))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("清理了 %d 个超过 %d 天的变更事件\n", n, eventRetentionDays)
+		return nil
+	},
+}
+
+func purgeReleased(before time.Time) (n int64, err error) {
+	db, err := conf.C().MySQL.GetDB()
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancelfunc := context.WithTimeout(context.Background(), purgeTimeout)
+	defer cancelfunc()
+
+	// 开启一个事务
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	deleteAt := before.UnixMilli()
+	for _, table := range purgeTables {
+		if _, err = tx.ExecContext(ctx, fmt.Sprintf(sqlPurgeReleased, table), deleteAt); err != nil {
+			return 0, fmt.Errorf("purge %s error, %s", table, err)
+		}
+	}
+
+	ret, err := tx.ExecContext(ctx, sqlPurgeReleasedResource, deleteAt)
+	if err != nil {
+		return 0, fmt.Errorf("purge resource error, %s", err)
+	}
+	return ret.RowsAffected()
+}
+
+// purgeEvents 变更事件是订阅的增量日志, 按创建时间单独清理
+func purgeEvents(before time.Time) (int64, error) {
+	db, err := conf.C().MySQL.GetDB()
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancelfunc := context.WithTimeout(context.Background(), purgeTimeout)
+	defer cancelfunc()
+
+	ret, err := db.ExecContext(ctx, sqlPurgeEvent, before.UnixMilli())
+	if err != nil {
+		return 0, fmt.Errorf("purge resource_event error, %s", err)
+	}
+	return ret.RowsAffected()
+}
+
+func init() {
+	purgeCmd.PersistentFlags().IntVarP(&retentionDays, "retention-days", "d", 30, "purge resources released more than N days ago")
+	purgeCmd.PersistentFlags().IntVar(&eventRetentionDays, "event-retention-days", 30, "purge resource change events created more than N days ago")
+	purgeCmd.PersistentFlags().DurationVar(&purgeTimeout, "timeout", 5*time.Minute, "the purge timeout")
+	RootCmd.AddCommand(purgeCmd)
+}
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/conf"
+)
+
+var (
+	reindexBatchSize int
+	reindexTimeout   time.Duration
+)
+
+const (
+	sqlReindexQueryResource = `SELECT id,IFNULL(public_ip,''),IFNULL(private_ip,'') FROM resource 
+	WHERE id > ? ORDER BY id LIMIT ?`
+	sqlReindexDeleteIP = `DELETE FROM resource_ip WHERE resource_id IN (?%s)`
+	sqlReindexInsertIP = `INSERT INTO resource_ip (resource_id,type,family,address,ip) VALUES `
+)
+
+// reindexIPCmd 根据资源表中的IP字段重建resource_ip, 用于升级后补全历史数据
+var reindexIPCmd = &cobra.Command{
+	Use:   "reindex-ip",
+	Short: "重建资源的IP索引",
+	Long:  "根据资源表中的public_ip和private_ip重建resource_ip, 升级后执行一次, 之后同步时会自动维护",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if reindexBatchSize <= 0 {
+			return fmt.Errorf("batch size must be greater than 0")
+		}
+
+		// 初始化全局变量
+		if err := loadGlobalConfig(confType); err != nil {
+			return err
+		}
+
+		db, err := conf.C().MySQL.GetDB()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancelfunc := context.WithTimeout(context.Background(), reindexTimeout)
+		defer cancelfunc()
+
+		// 按资源Id分批重建, 每个批次一个事务
+		total, indexed, cursor := 0, 0, ""
+		for {
+			items, err := queryReindexResource(ctx, db, cursor)
+			if err != nil {
+				return err
+			}
+			if len(items) == 0 {
+				break
+			}
+			n, err := reindexIP(ctx, db, items)
+			if err != nil {
+				return err
+			}
+			total += len(items)
+			indexed += n
+			cursor = items[len(items)-1].Id
+		}
+
+		fmt.Printf("重建了 %d 个资源的 %d 个IP\n", total, indexed)
+		return nil
+	},
+}
+
+func queryReindexResource(ctx context.Context, db *sql.DB, cursor string) ([]*resource.Resource, error) {
+	rows, err := db.QueryContext(ctx, sqlReindexQueryResource, cursor, reindexBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*resource.Resource{}
+	for rows.Next() {
+		ins := resource.NewDefaultResource()
+		var publicIP, privateIP string
+		if err := rows.Scan(&ins.Id, &publicIP, &privateIP); err != nil {
+			return nil, err
+		}
+		ins.LoadPublicIPString(publicIP)
+		ins.LoadPrivateIPString(privateIP)
+		items = append(items, ins)
+	}
+	return items, rows.Err()
+}
+
+func reindexIP(ctx context.Context, db *sql.DB, items []*resource.Resource) (n int, err error) {
+	// 开启一个事务
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	ids := make([]interface{}, 0, len(items))
+	values := []string{}
+	args := []interface{}{}
+	for _, ins := range items {
+		ids = append(ids, ins.Id)
+		for _, ip := range ins.IPs() {
+			values = append(values, "(?,?,?,?,?)")
+			args = append(args, ip.ResourceId, ip.Type, ip.Family, ip.Address, ip.Bytes())
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, fmt.Sprintf(sqlReindexDeleteIP, strings.Repeat(",?", len(ids)-1)), ids...); err != nil {
+		return 0, fmt.Errorf("delete resource ip error, %s", err)
+	}
+	if len(values) == 0 {
+		return 0, nil
+	}
+	if _, err = tx.ExecContext(ctx, sqlReindexInsertIP+strings.Join(values, ","), args...); err != nil {
+		return 0, fmt.Errorf("insert resource ip error, %s", err)
+	}
+	return len(values), nil
+}
+
+func init() {
+	reindexIPCmd.PersistentFlags().IntVar(&reindexBatchSize, "batch-size", 500, "the number of resources reindexed in one transaction")
+	reindexIPCmd.PersistentFlags().DurationVar(&reindexTimeout, "timeout", 30*time.Minute, "the reindex timeout")
+	RootCmd.AddCommand(reindexIPCmd)
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+)
+
+const (
+	// HTTP请求头和gRPC元数据中携带凭证的字段, 格式: Bearer <token>
+	AuthorizationHeader = "Authorization"
+
+	bearerPrefix = "Bearer "
+)
+
+// Identity 认证后的调用方身份
+type Identity struct {
+	// 身份名称, 用于日志
+	Name string `toml:"name"`
+	// 调用方所属空间
+	Namespace string `toml:"namespace"`
+	// 调用方的身份标签, 比如 app=app1, user=user1
+	Tags map[string]string `toml:"tags"`
+}
+
+// Actor 调用方的标识, 用于记录变更人, 优先使用user标签, 其次是app标签和所属空间
+func (i *Identity) Actor() string {
+	if i == nil {
+		return ""
+	}
+	for _, k := range []string{"user", "app"} {
+		if v := i.Tags[k]; v != "" {
+			return k + ":" + v
+		}
+	}
+	if i.Namespace != "" {
+		return "namespace:" + i.Namespace
+	}
+	return ""
+}
+
+type (
+	identityKey struct{}
+	internalKey struct{}
+)
+
+// WithIdentity 把认证后的身份写入上下文
+func WithIdentity(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext 从上下文中加载认证后的身份, 没有认证时返回nil
+func FromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(identityKey{}).(*Identity)
+	return id
+}
+
+// NewInternalContext 服务内部调用(比如定时任务)使用的上下文, 不做调用方的权限校验
+// 该标记只能在进程内设置, 外部的HTTP和gRPC请求无法携带
+func NewInternalContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internalKey{}, true)
+}
+
+// IsInternal 是否是服务内部调用
+func IsInternal(ctx context.Context) bool {
+	v, _ := ctx.Value(internalKey{}).(bool)
+	return v
+}
+
+// Client 配置的客户端, 每个token对应一个调用方身份
+type Client struct {
+	Token string `toml:"token"`
+	Identity
+}
+
+// Authenticator 通过静态配置的token认证调用方
+type Authenticator struct {
+	clients []*Client
+}
+
+func NewAuthenticator(clients []*Client) *Authenticator {
+	return &Authenticator{
+		clients: clients,
+	}
+}
+
+// Authenticate 根据Authorization的值认证调用方, 没有携带凭证时返回nil, 凭证无效时返回错误
+func (a *Authenticator) Authenticate(authorization string) (*Identity, error) {
+	authorization = strings.TrimSpace(authorization)
+	if authorization == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return nil, fmt.Errorf("invalid %s, format: Bearer <token>", AuthorizationHeader)
+	}
+
+	token := []byte(strings.TrimSpace(strings.TrimPrefix(authorization, bearerPrefix)))
+	for _, c := range a.clients {
+		if c.Token != "" && subtle.ConstantTimeCompare(token, []byte(c.Token)) == 1 {
+			id := c.Identity
+			return &id, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid token")
+}
@@ -0,0 +1,57 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opengoats/cmdb/common/auth"
+)
+
+func TestAuthenticate(t *testing.T) {
+	should := assert.New(t)
+
+	var cfg struct {
+		Clients []*auth.Client `toml:"clients"`
+	}
+	_, err := toml.Decode(`
+[[clients]]
+token = "t1"
+name = "app1"
+namespace = "team-a"
+tags = { app = "app1" }
+`, &cfg)
+	should.NoError(err)
+	a := auth.NewAuthenticator(cfg.Clients)
+
+	// 没有携带凭证
+	id, err := a.Authenticate("")
+	should.NoError(err)
+	should.Nil(id)
+
+	id, err = a.Authenticate("Bearer t1")
+	if should.NoError(err) {
+		should.Equal("team-a", id.Namespace)
+		should.Equal("app:app1", id.Actor())
+	}
+
+	_, err = a.Authenticate("Bearer t2")
+	should.Error(err)
+	_, err = a.Authenticate("t1")
+	should.Error(err)
+}
+
+func TestContext(t *testing.T) {
+	should := assert.New(t)
+
+	ctx := context.Background()
+	should.Nil(auth.FromContext(ctx))
+	should.False(auth.IsInternal(ctx))
+
+	should.True(auth.IsInternal(auth.NewInternalContext(ctx)))
+	id := &auth.Identity{Namespace: "team-a"}
+	should.Equal(id, auth.FromContext(auth.WithIdentity(ctx, id)))
+	should.Equal("namespace:team-a", id.Actor())
+}
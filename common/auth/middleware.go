@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/opengoats/goat/exception"
+	"github.com/opengoats/goat/http/response"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RestfulFilter 认证HTTP请求, 认证后的身份写入请求的上下文, 没有携带凭证的请求由各个接口决定是否允许
+func (a *Authenticator) RestfulFilter(r *restful.Request, w *restful.Response, chain *restful.FilterChain) {
+	id, err := a.Authenticate(r.HeaderParameter(AuthorizationHeader))
+	if err != nil {
+		response.Failed(w.ResponseWriter, exception.NewUnauthorized("authenticate error, %s", err))
+		return
+	}
+	if id != nil {
+		r.Request = r.Request.WithContext(WithIdentity(r.Request.Context(), id))
+	}
+	chain.ProcessFilter(r, w)
+}
+
+// UnaryServerInterceptor 认证gRPC请求, 凭证通过authorization元数据传递
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticateGRPC(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor 认证gRPC流式请求
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticateGRPC(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (a *Authenticator) authenticateGRPC(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	id, err := a.Authenticate(strings.Join(md.Get(AuthorizationHeader), ""))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authenticate error, %s", err)
+	}
+	if id != nil {
+		ctx = WithIdentity(ctx, id)
+	}
+	return ctx, nil
+}
+
+// serverStream 替换流的上下文, 服务端通过stream.Context()获取认证后的身份
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
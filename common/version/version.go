@@ -0,0 +1,62 @@
+package version
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/opengoats/goat/exception"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// 返回数据当前的版本号
+	ETagHeader = "ETag"
+	// 修改时期望的版本号, 与ETag的格式相同, 比如 "3"
+	IfMatchHeader = "If-Match"
+)
+
+// Conflict 期望的版本号与当前版本不一致, HTTP接口返回412, gRPC接口返回Aborted
+type Conflict struct {
+	exception.APIException
+}
+
+func NewConflict(format string, a ...interface{}) *Conflict {
+	return &Conflict{
+		APIException: exception.NewAPIException("", http.StatusPreconditionFailed, "版本冲突", format, a...),
+	}
+}
+
+// GRPCStatus gRPC服务端通过该方法获取错误的状态码
+func (e *Conflict) GRPCStatus() *status.Status {
+	return status.New(codes.Aborted, e.Error())
+}
+
+// Check 期望的版本号为0时不校验, kind和id用于错误信息
+func Check(kind, id string, current, expected int64) error {
+	if expected == 0 || expected == current {
+		return nil
+	}
+	return NewConflict("%s %s version is %d, expected %d", kind, id, current, expected)
+}
+
+// NewETag 版本号作为强校验的ETag
+func NewETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ParseIfMatch 从If-Match请求头中加载期望的版本号, 没有传递或者为*时返回0, 表示不校验
+func ParseIfMatch(r *http.Request) (int64, error) {
+	v := strings.TrimSpace(r.Header.Get(IfMatchHeader))
+	if v == "" || v == "*" {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(v, "W/"), `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("invalid %s %s, format: \"<version>\"", IfMatchHeader, v)
+	}
+	return version, nil
+}
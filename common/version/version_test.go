@@ -0,0 +1,66 @@
+package version_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opengoats/goat/exception"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/opengoats/cmdb/common/version"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	should := assert.New(t)
+
+	cases := map[string]int64{
+		"":      0,
+		"*":     0,
+		`"3"`:   3,
+		`W/"4"`: 4,
+		"5":     5,
+	}
+	for header, want := range cases {
+		r := httptest.NewRequest("PUT", "/", nil)
+		r.Header.Set(version.IfMatchHeader, header)
+		v, err := version.ParseIfMatch(r)
+		if should.NoError(err, header) {
+			should.Equal(want, v, header)
+		}
+	}
+
+	for _, header := range []string{`"abc"`, `"0"`, `"-1"`} {
+		r := httptest.NewRequest("PUT", "/", nil)
+		r.Header.Set(version.IfMatchHeader, header)
+		_, err := version.ParseIfMatch(r)
+		should.Error(err, header)
+	}
+
+	// ETag可以直接作为If-Match使用
+	r := httptest.NewRequest("PUT", "/", nil)
+	r.Header.Set(version.IfMatchHeader, version.NewETag(7))
+	v, err := version.ParseIfMatch(r)
+	if should.NoError(err) {
+		should.Equal(int64(7), v)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	should := assert.New(t)
+
+	should.NoError(version.Check("book", "b1", 3, 0))
+	should.NoError(version.Check("book", "b1", 3, 3))
+
+	err := version.Check("book", "b1", 3, 2)
+	if should.Error(err) {
+		e, ok := err.(exception.APIException)
+		if should.True(ok) {
+			should.Equal(http.StatusPreconditionFailed, e.GetHttpCode())
+		}
+		should.Equal(codes.Aborted, status.Code(err))
+		should.Contains(err.Error(), "book b1 version is 3, expected 2")
+	}
+}
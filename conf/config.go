@@ -8,6 +8,8 @@ import (
 
 	"database/sql"
 	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/opengoats/cmdb/common/auth"
 )
 
 var (
@@ -16,17 +18,25 @@ var (
 
 func newConfig() *Config {
 	return &Config{
-		App:   newDefaultAPP(),
-		Log:   newDefaultLog(),
-		MySQL: newDefaultMySQL(),
+		App:      newDefaultAPP(),
+		Log:      newDefaultLog(),
+		MySQL:    newDefaultMySQL(),
+		Provider: newDefaultProvider(),
+		Reminder: newDefaultReminder(),
+		Task:     newDefaultTask(),
+		Auth:     newDefaultAuthentication(),
 	}
 }
 
 // Config 应用配置
 type Config struct {
-	App   *app   `toml:"app"`
-	Log   *log   `toml:"log"`
-	MySQL *mysql `toml:"mysql"`
+	App      *app            `toml:"app"`
+	Log      *log            `toml:"log"`
+	MySQL    *mysql          `toml:"mysql"`
+	Provider *provider       `toml:"provider"`
+	Reminder *reminder       `toml:"reminder"`
+	Task     *task           `toml:"task"`
+	Auth     *authentication `toml:"auth"`
 }
 
 type app struct {
@@ -99,6 +109,57 @@ func newDefaultLog() *log {
 	}
 }
 
+type provider struct {
+	// 模拟资源提供商的数据文件, 配置后加载模拟的资源提供商, 用于本地测试同步
+	FakeFixture string `toml:"fake_fixture" env:"PROVIDER_FAKE_FIXTURE"`
+}
+
+func newDefaultProvider() *provider {
+	return &provider{}
+}
+
+type reminder struct {
+	// 续费提醒的Webhook地址, 为空时不启动过期扫描
+	Webhook string `toml:"webhook" env:"REMINDER_WEBHOOK"`
+	// 扫描间隔, 单位分钟
+	Interval int `toml:"interval" env:"REMINDER_INTERVAL"`
+	// 提醒窗口, 单位天, 同一个资源在同一个窗口内只提醒一次
+	Windows []int64 `toml:"windows" env:"REMINDER_WINDOWS" envSeparator:","`
+	// 需要提醒的付费方式, 忽略大小写, 为空时使用各个厂商包年包月的付费方式
+	PayTypes []string `toml:"pay_types" env:"REMINDER_PAY_TYPES" envSeparator:","`
+	// 消息模版文件, Go template格式, 为空时使用默认的JSON模版
+	TemplateFile string `toml:"template_file" env:"REMINDER_TEMPLATE_FILE"`
+}
+
+func newDefaultReminder() *reminder {
+	return &reminder{
+		Interval: 60,
+		Windows:  []int64{30, 7, 1},
+	}
+}
+
+type task struct {
+	// 每个实例同时执行的同步任务数量上限, 超过时拒绝创建新的任务
+	MaxRunning int `toml:"max_running" env:"TASK_MAX_RUNNING"`
+}
+
+func newDefaultTask() *task {
+	return &task{
+		MaxRunning: 5,
+	}
+}
+
+type authentication struct {
+	// 调用方的凭证, 每个token对应一个调用方身份, 只支持在配置文件中配置
+	Clients []*auth.Client `toml:"clients"`
+}
+
+func newDefaultAuthentication() *authentication {
+	return &authentication{
+		Clients: []*auth.Client{},
+	}
+}
+
 type mysql struct {
 	Host        string `toml:"host" env:"MYSQL_HOST"`
 	Port        string `toml:"port" env:"MYSQL_PORT"`
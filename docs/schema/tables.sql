@@ -9,6 +9,7 @@ CREATE TABLE IF NOT EXISTS `books` (
   `delete_by` varchar(255) DEFAULT '' COMMENT '删除人',
   `book_name` varchar(255) NOT NULL DEFAULT '' COMMENT '书名',
   `author` varchar(255) NOT NULL DEFAULT '' COMMENT '作者',
+  `version` bigint NOT NULL DEFAULT 1 COMMENT '版本号, 每次变更加1, 用于乐观锁',
   PRIMARY KEY (`id`),
   KEY `idx_book_name` (`book_name`) USING BTREE COMMENT '用于书名搜索',
   KEY `idx_author` (`author`) USING BTREE COMMENT '用于作者搜索'
@@ -37,8 +38,8 @@ CREATE TABLE IF NOT EXISTS `resource` (
   `c_status` varchar(255) NOT NULL COMMENT '服务商中的状态',
   `sync_at` bigint(13) DEFAULT NULL COMMENT '同步时间',
   `sync_accout` varchar(255) DEFAULT NULL COMMENT '同步账号',
-  `public_ip` varchar(64) DEFAULT NULL COMMENT '公网IP',
-  `private_ip` varchar(64) DEFAULT NULL COMMENT '内网IP',
+  `public_ip` varchar(255) DEFAULT NULL COMMENT '公网IP, 多个以逗号分隔, 按IP查询使用resource_ip',
+  `private_ip` varchar(255) DEFAULT NULL COMMENT '内网IP, 多个以逗号分隔, 按IP查询使用resource_ip',
   `pay_type` varchar(255) DEFAULT NULL COMMENT '实例付费方式',
   `describe_hash` varchar(255) NOT NULL COMMENT '描述数据Hash',
   `resource_hash` varchar(255) NOT NULL COMMENT '基础数据Hash',
@@ -47,10 +48,14 @@ CREATE TABLE IF NOT EXISTS `resource` (
   `namespace` varchar(255) NOT NULL COMMENT '资源所属空间',
   `env` varchar(255) NOT NULL COMMENT '资源所属环境',
   `usage_mode` tinyint(2) NOT NULL COMMENT '资源使用方式',
+  `shared_tag_key` varchar(255) NOT NULL DEFAULT '' COMMENT '共享策略的标签Key, 为空时共享给所有调用方',
+  `shared_tag_values` varchar(1024) NOT NULL DEFAULT '' COMMENT '共享策略的标签值, 多个值以逗号分隔',
+  `version` bigint(20) NOT NULL DEFAULT 1 COMMENT '版本号, 每次变更(包括标签和共享策略)加1, 用于乐观锁',
   PRIMARY KEY (`id`) USING BTREE,
+  UNIQUE KEY `idx_vendor_cid` (`vendor`,`c_id`) COMMENT '同一个厂商下云商Id唯一',
   KEY `idx_name` (`name`) USING BTREE,
   KEY `idx_c_status` (`c_status`) USING BTREE,
-  KEY `idx_private_ip` (`public_ip`) USING BTREE,
+  KEY `idx_private_ip` (`private_ip`) USING BTREE,
   KEY `idx_public_ip` (`public_ip`) USING BTREE,
   KEY `idx_domain` (`domain`) USING HASH,
   KEY `idx_namespace` (`namespace`) USING HASH,
@@ -70,13 +75,26 @@ CREATE TABLE IF NOT EXISTS `resource_tag` (
   `t_value` varchar(255) NOT NULL COMMENT '标签的值',
   `description` varchar(255) NOT NULL COMMENT '值的描述信息',
   `weight` int(11) NOT NULL COMMENT '标签权重',
+  `is_cost` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否纳入成本统计',
   `type` tinyint(4) NOT NULL COMMENT '标签类型',
+  `hidden` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否在前端隐藏',
+  `meta` text COMMENT '标签meta信息, json格式',
   UNIQUE KEY `idx_id` (`t_key`,`t_value`,`resource_id`) COMMENT '一个资源同一个key value只允许有一对',
   KEY `idx_key` (`t_key`) USING HASH,
   KEY `idx_value` (`t_value`) USING BTREE,
   KEY `idx_resource_id` (`resource_id`) USING HASH
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='资源标签';
 
+CREATE TABLE IF NOT EXISTS `resource_ip` (
+  `resource_id` varchar(64) NOT NULL COMMENT '关联的资源Id',
+  `type` tinyint(1) NOT NULL COMMENT 'IP类型, 0:内网, 1:公网',
+  `family` tinyint(1) NOT NULL COMMENT '地址族, 4:IPv4, 6:IPv6',
+  `address` varchar(64) NOT NULL COMMENT 'IP地址的标准格式',
+  `ip` varbinary(16) NOT NULL COMMENT 'IP地址的16字节格式, IPv4使用IPv4-mapped格式, 用于网段和范围查询',
+  PRIMARY KEY (`resource_id`,`type`,`ip`) USING BTREE,
+  KEY `idx_ip` (`ip`,`type`) USING BTREE COMMENT '用于IP反查资源'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='资源的IP地址, 每个地址一行';
+
 CREATE TABLE IF NOT EXISTS `resource_host` (
   `resource_id` varchar(64)  NOT NULL COMMENT '关联的资源Id',
   `cpu` tinyint(4) NOT NULL COMMENT 'cpu核数',
@@ -122,5 +140,64 @@ CREATE TABLE IF NOT EXISTS `task` (
   `end_at` bigint(20) NOT NULL COMMENT '任务结束时间',
   `total_succeed` int(11) NOT NULL COMMENT '总共操作成功的资源数量',
   `total_failed` int(11) NOT NULL COMMENT '总共操作失败的资源数量',
-  PRIMARY KEY (`id`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='资源操作任务管理';
\ No newline at end of file
+  `total_released` int(11) NOT NULL DEFAULT 0 COMMENT '完整同步后释放的资源数量',
+  `heartbeat_at` bigint(20) NOT NULL DEFAULT 0 COMMENT '执行任务的实例最近一次上报心跳的时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_status` (`status`,`heartbeat_at`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='资源操作任务管理';
+
+CREATE TABLE IF NOT EXISTS `bill` (
+  `id` varchar(64) NOT NULL COMMENT '账单Id',
+  `create_at` bigint(13) NOT NULL COMMENT '导入时间',
+  `vendor` tinyint(1) NOT NULL COMMENT '资源提供商',
+  `account` varchar(255) NOT NULL COMMENT '账单所属账号',
+  `month` char(7) NOT NULL COMMENT '账单月份, 格式: 2006-01',
+  `resource_id` varchar(64) NOT NULL DEFAULT '' COMMENT '关联的资源Id, 没有关联到资源时为空',
+  `c_id` varchar(128) NOT NULL DEFAULT '' COMMENT '云商的实例Id',
+  `instance_name` varchar(255) NOT NULL DEFAULT '' COMMENT '实例名称',
+  `product` varchar(255) NOT NULL DEFAULT '' COMMENT '产品名称',
+  `region` varchar(64) NOT NULL DEFAULT '' COMMENT '地域',
+  `namespace` varchar(255) NOT NULL DEFAULT '' COMMENT '资源所属空间',
+  `env` varchar(255) NOT NULL DEFAULT '' COMMENT '资源所属环境',
+  `pay_type` varchar(255) NOT NULL DEFAULT '' COMMENT '付费方式',
+  `currency` varchar(16) NOT NULL DEFAULT '' COMMENT '币种',
+  `amount` decimal(20,6) NOT NULL DEFAULT 0 COMMENT '应付金额',
+  PRIMARY KEY (`id`),
+  KEY `idx_import` (`vendor`,`account`,`month`) USING BTREE COMMENT '同一个账号同一个月份的账单整体替换',
+  KEY `idx_month` (`month`) USING BTREE,
+  KEY `idx_resource_id` (`resource_id`) USING BTREE,
+  KEY `idx_namespace` (`namespace`) USING HASH,
+  KEY `idx_env` (`env`) USING HASH
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='资源账单, 从厂商导出的账单导入, 用于成本分摊';
+
+CREATE TABLE IF NOT EXISTS `resource_reminder` (
+  `resource_id` varchar(64) NOT NULL COMMENT '提醒的资源Id',
+  `window_days` int NOT NULL COMMENT '提醒窗口, 单位天',
+  `expire_at` bigint(13) NOT NULL COMMENT '提醒时资源的过期时间, 续费后过期时间变化会重新提醒',
+  `send_at` bigint(13) NOT NULL COMMENT '提醒时间',
+  PRIMARY KEY (`resource_id`,`window_days`,`expire_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='资源续费提醒记录, 用于同一个窗口内的提醒去重';
+
+CREATE TABLE IF NOT EXISTS `resource_revision` (
+  `id` varchar(64) NOT NULL COMMENT '变更Id',
+  `resource_id` varchar(64) NOT NULL COMMENT '资源Id',
+  `create_at` bigint(13) NOT NULL COMMENT '变更时间',
+  `actor` varchar(255) NOT NULL DEFAULT '' COMMENT '变更人, 同步任务为task:<任务Id>',
+  `action` tinyint(1) NOT NULL COMMENT '变更类型, 0:新建, 1:同步更新, 2:修改标签',
+  `changed_fields` text NOT NULL COMMENT '变化的字段, 多个字段以逗号分隔',
+  `before_snapshot` mediumtext COMMENT '变更前的快照, 新建时为空',
+  `after_snapshot` mediumtext NOT NULL COMMENT '变更后的快照',
+  PRIMARY KEY (`id`),
+  KEY `idx_resource_id` (`resource_id`,`create_at`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='资源变更历史, 记录每次变更前后的快照';
+
+CREATE TABLE IF NOT EXISTS `resource_event` (
+  `revision` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '事件版本号, 单调递增',
+  `resource_id` varchar(64) NOT NULL COMMENT '资源Id',
+  `resource_type` tinyint(1) NOT NULL COMMENT '资源类型',
+  `type` tinyint(1) NOT NULL COMMENT '事件类型, 0:新建, 1:更新, 2:释放, 3:修改标签',
+  `create_at` bigint(13) NOT NULL COMMENT '事件时间',
+  `actor` varchar(255) NOT NULL DEFAULT '' COMMENT '变更人, 同步任务为task:<任务Id>',
+  PRIMARY KEY (`revision`),
+  KEY `idx_create_at` (`create_at`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='资源变更事件, 用于订阅资源的变化, 与资源的变更在同一个事务中写入';
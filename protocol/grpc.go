@@ -10,6 +10,7 @@ import (
 	"github.com/opengoats/goat/logger"
 	"github.com/opengoats/goat/logger/zap"
 
+	"github.com/opengoats/cmdb/common/auth"
 	"github.com/opengoats/cmdb/conf"
 )
 
@@ -18,9 +19,18 @@ func NewGRPCService() *GRPCService {
 	log := zap.L().Named("GRPC Service")
 
 	rc := recovery.NewInterceptor(recovery.NewZapRecoveryHandler())
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
-		rc.UnaryServerInterceptor(),
-	))
+	// 认证调用方, 凭证通过authorization元数据传递
+	authn := auth.NewAuthenticator(conf.C().Auth.Clients)
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			rc.UnaryServerInterceptor(),
+			authn.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			rc.StreamServerInterceptor(),
+			authn.StreamServerInterceptor(),
+		),
+	)
 
 	return &GRPCService{
 		svr: grpcServer,
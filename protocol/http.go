@@ -13,6 +13,7 @@ import (
 
 	"github.com/opengoats/goat/app"
 
+	"github.com/opengoats/cmdb/common/auth"
 	"github.com/opengoats/cmdb/conf"
 	"github.com/opengoats/cmdb/swagger"
 )
@@ -34,6 +35,9 @@ func NewHTTPService() *HTTPService {
 		Container:      r}
 	r.Filter(cors.Filter)
 
+	// 认证调用方, 认证后的身份通过请求的上下文传递给各个服务
+	r.Filter(auth.NewAuthenticator(conf.C().Auth.Clients).RestfulFilter)
+
 	server := &http.Server{
 		ReadHeaderTimeout: 60 * time.Second,
 		ReadTimeout:       60 * time.Second,
@@ -0,0 +1,47 @@
+package fake
+
+import (
+	"github.com/opengoats/goat/app"
+	"github.com/opengoats/goat/logger/zap"
+
+	"github.com/opengoats/cmdb/conf"
+	"github.com/opengoats/cmdb/provider"
+)
+
+const (
+	AppName = "fake_provider"
+)
+
+var (
+	svr = &loader{}
+)
+
+// loader 配置了数据文件时, 按数据文件中出现的资源提供商和资源类型注册模拟的资源提供商
+type loader struct{}
+
+func (l *loader) Config() error {
+	path := conf.C().Provider.FakeFixture
+	if path == "" {
+		return nil
+	}
+
+	f, err := LoadFixture(path)
+	if err != nil {
+		return err
+	}
+	vendors, types := f.Keys()
+	for i := range vendors {
+		provider.Registry(NewProvider(vendors[i], types[i], path))
+	}
+
+	zap.L().Named(AppName).Infof("loaded fake provider: %s", provider.Loaded())
+	return nil
+}
+
+func (l *loader) Name() string {
+	return AppName
+}
+
+func init() {
+	app.RegistryInternalApp(svr)
+}
@@ -0,0 +1,154 @@
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/provider"
+)
+
+// Fixture 模拟的资源数据文件
+type Fixture struct {
+	Items []*FixtureItem `json:"items"`
+}
+
+// FixtureItem 一个模拟的资源, describe按资源类型解析, 主机为host.Describe, 其他资源为map[string]string
+type FixtureItem struct {
+	Resource *resource.Resource `json:"resource"`
+	Describe json.RawMessage    `json:"describe"`
+}
+
+// LoadFixture 读取数据文件
+func LoadFixture(path string) (*Fixture, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Fixture{}
+	if err := json.Unmarshal(b, f); err != nil {
+		return nil, fmt.Errorf("load fixture %s error, %s", path, err)
+	}
+	for i := range f.Items {
+		if f.Items[i].Resource == nil {
+			return nil, fmt.Errorf("load fixture %s error, item %d resource required", path, i)
+		}
+	}
+	return f, nil
+}
+
+// Keys 数据文件中出现的资源提供商和资源类型
+func (f *Fixture) Keys() (vendors []resource.Vendor, types []resource.Type) {
+	exist := map[string]bool{}
+	for i := range f.Items {
+		r := f.Items[i].Resource
+		k := r.Vendor.String() + "/" + r.ResourceType.String()
+		if exist[k] {
+			continue
+		}
+		exist[k] = true
+		vendors = append(vendors, r.Vendor)
+		types = append(types, r.ResourceType)
+	}
+	return
+}
+
+// Provider 基于数据文件的模拟资源提供商
+// 每次拉取都会重新读取数据文件, 修改数据文件即可模拟远端资源的变更和删除
+type Provider struct {
+	vendor  resource.Vendor
+	rtype   resource.Type
+	fixture string
+}
+
+func NewProvider(vendor resource.Vendor, t resource.Type, fixture string) *Provider {
+	return &Provider{
+		vendor:  vendor,
+		rtype:   t,
+		fixture: fixture,
+	}
+}
+
+func (p *Provider) Vendor() resource.Vendor {
+	return p.vendor
+}
+
+func (p *Provider) Type() resource.Type {
+	return p.rtype
+}
+
+// Query 按c_id排序后分页返回, 保证每次拉取的结果一致
+func (p *Provider) Query(ctx context.Context, req *provider.QueryRequest, fn provider.PageHandler) error {
+	f, err := LoadFixture(p.fixture)
+	if err != nil {
+		return err
+	}
+
+	items := []*provider.Item{}
+	for i := range f.Items {
+		fi := f.Items[i]
+		r := fi.Resource
+		if !r.Vendor.Equal(p.vendor) || !r.ResourceType.Equal(p.rtype) || r.Region != req.Region {
+			continue
+		}
+
+		item, err := p.newItem(fi)
+		if err != nil {
+			return fmt.Errorf("resource %s %s", r.Cid, err)
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Resource.Cid < items[j].Resource.Cid
+	})
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = provider.DefaultPageSize
+	}
+	for start := 0; start < len(items); start += pageSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		end := start + pageSize
+		if end > len(items) {
+			end = len(items)
+		}
+		if err := fn(items[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Provider) newItem(fi *FixtureItem) (*provider.Item, error) {
+	item := &provider.Item{Resource: fi.Resource}
+
+	switch p.rtype {
+	case resource.Type_HOST:
+		d := host.NewDefaultDescribe()
+		if len(fi.Describe) > 0 {
+			if err := json.Unmarshal(fi.Describe, d); err != nil {
+				return nil, fmt.Errorf("load host describe error, %s", err)
+			}
+		}
+		item.Detail = d
+	default:
+		d := map[string]string{}
+		if len(fi.Describe) > 0 {
+			if err := json.Unmarshal(fi.Describe, &d); err != nil {
+				return nil, fmt.Errorf("load describe error, %s", err)
+			}
+		}
+		item.Detail = d
+	}
+
+	return item, nil
+}
@@ -0,0 +1,98 @@
+package fake_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opengoats/cmdb/apps/host"
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/provider"
+	"github.com/opengoats/cmdb/provider/fake"
+)
+
+const fixture = "testdata/fixture.json"
+
+func TestProviderQuery(t *testing.T) {
+	should := assert.New(t)
+
+	p := fake.NewProvider(resource.Vendor_ALIYUN, resource.Type_HOST, fixture)
+	req := provider.NewQueryRequest(nil, "cn-hangzhou")
+	req.PageSize = 1
+
+	pages := [][]string{}
+	err := p.Query(context.Background(), req, func(page []*provider.Item) error {
+		cids := []string{}
+		for i := range page {
+			cids = append(cids, page[i].Resource.Cid)
+			_, ok := page[i].Detail.(*host.Describe)
+			should.True(ok)
+		}
+		pages = append(pages, cids)
+		return nil
+	})
+	if should.NoError(err) {
+		// 按c_id排序, 与数据文件中的顺序无关
+		should.Equal([][]string{{"i-001"}, {"i-002"}}, pages)
+	}
+
+	// 每次拉取的结果以及Hash保持一致
+	hashes := map[string]string{}
+	for n := 0; n < 2; n++ {
+		err := p.Query(context.Background(), provider.NewQueryRequest(nil, "cn-hangzhou"), func(page []*provider.Item) error {
+			for i := range page {
+				h := page[i].Resource.ComputeResourceHash()
+				if exist, ok := hashes[page[i].Resource.Cid]; ok {
+					should.Equal(exist, h)
+				}
+				hashes[page[i].Resource.Cid] = h
+			}
+			return nil
+		})
+		should.NoError(err)
+	}
+	should.Len(hashes, 2)
+}
+
+func TestProviderQueryDescribe(t *testing.T) {
+	should := assert.New(t)
+
+	p := fake.NewProvider(resource.Vendor_ALIYUN, resource.Type_RDS, fixture)
+	items := []*provider.Item{}
+	err := p.Query(context.Background(), provider.NewQueryRequest(nil, "cn-hangzhou"), func(page []*provider.Item) error {
+		items = append(items, page...)
+		return nil
+	})
+	if should.NoError(err) && should.Len(items, 1) {
+		should.Equal(map[string]string{"engine": "MySQL", "engine_version": "8.0"}, items[0].Detail)
+	}
+}
+
+func TestFixtureKeys(t *testing.T) {
+	should := assert.New(t)
+
+	f, err := fake.LoadFixture(fixture)
+	if should.NoError(err) {
+		vendors, types := f.Keys()
+		should.Equal([]resource.Vendor{resource.Vendor_ALIYUN, resource.Vendor_ALIYUN}, vendors)
+		should.Equal([]resource.Type{resource.Type_HOST, resource.Type_RDS}, types)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	should := assert.New(t)
+
+	provider.Registry(fake.NewProvider(resource.Vendor_TENCENT, resource.Type_HOST, fixture))
+	p, err := provider.Get(resource.Vendor_TENCENT, resource.Type_HOST)
+	if should.NoError(err) {
+		should.Equal(resource.Vendor_TENCENT, p.Vendor())
+	}
+
+	should.Panics(func() {
+		provider.Registry(fake.NewProvider(resource.Vendor_TENCENT, resource.Type_HOST, fixture))
+	})
+
+	_, err = provider.Get(resource.Vendor_HUAWEI, resource.Type_HOST)
+	should.Error(err)
+}
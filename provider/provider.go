@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengoats/cmdb/apps/resource"
+	"github.com/opengoats/cmdb/apps/secret"
+)
+
+const (
+	// DefaultPageSize 默认每页拉取的资源数量
+	DefaultPageSize = 20
+)
+
+var (
+	providers = map[string]Provider{}
+)
+
+// Provider 资源提供商, 按页从远端拉取某一种类型的资源
+type Provider interface {
+	// 资源提供商
+	Vendor() resource.Vendor
+	// 资源类型
+	Type() resource.Type
+	// Query 按页拉取资源, 每拉取一页调用一次fn, fn返回错误时停止拉取
+	Query(ctx context.Context, req *QueryRequest, fn PageHandler) error
+}
+
+// PageHandler 处理一页资源
+type PageHandler func(page []*Item) error
+
+// QueryRequest 拉取资源的参数
+type QueryRequest struct {
+	// 解密后的凭证
+	Secret *secret.Secret
+	// 拉取的Region
+	Region string
+	// 每页的资源数量
+	PageSize int
+}
+
+func NewQueryRequest(s *secret.Secret, region string) *QueryRequest {
+	return &QueryRequest{
+		Secret:   s,
+		Region:   region,
+		PageSize: DefaultPageSize,
+	}
+}
+
+// Item 远端的一个资源
+type Item struct {
+	// 资源通用信息
+	Resource *resource.Resource
+	// 资源特有信息, 比如主机为*host.Describe, 没有特有信息的资源为map[string]string
+	Detail interface{}
+}
+
+func key(vendor resource.Vendor, t resource.Type) string {
+	return fmt.Sprintf("%s/%s", vendor, t)
+}
+
+// Registry 注册资源提供商, 同一个资源提供商的同一种资源只允许注册一次
+func Registry(p Provider) {
+	k := key(p.Vendor(), p.Type())
+	if _, ok := providers[k]; ok {
+		panic(fmt.Sprintf("provider %s has registed", k))
+	}
+
+	providers[k] = p
+}
+
+// Get 查询资源提供商
+func Get(vendor resource.Vendor, t resource.Type) (Provider, error) {
+	p, ok := providers[key(vendor, t)]
+	if !ok {
+		return nil, fmt.Errorf("provider %s not registed", key(vendor, t))
+	}
+
+	return p, nil
+}
+
+// Loaded 查询已经注册的资源提供商
+func Loaded() (ps []string) {
+	for k := range providers {
+		ps = append(ps, k)
+	}
+	return
+}